@@ -0,0 +1,13 @@
+data "defectdojo_findings" "open_criticals" {
+  product_id = 1
+  severity   = "Critical"
+  active     = true
+  duplicate  = false
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.findings) == 0
+      error_message = "The product has open Critical findings: ${join(", ", self.findings[*].title)}"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "defectdojo_jira_instance" "example" {
+  url = "https://example.atlassian.net"
+}
+
+resource "defectdojo_jira_product_configuration" "example" {
+  product_id       = defectdojo_product.example.id
+  jira_instance_id = data.defectdojo_jira_instance.example.id
+  project_key      = "APPSEC"
+}
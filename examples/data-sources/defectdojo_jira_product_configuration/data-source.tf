@@ -0,0 +1,7 @@
+data "defectdojo_jira_product_configuration" "example" {
+  product_id = data.defectdojo_product.example.id
+}
+
+output "jira_project_key" {
+  value = data.defectdojo_jira_product_configuration.example.project_key
+}
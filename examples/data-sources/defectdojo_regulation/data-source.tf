@@ -0,0 +1,17 @@
+data "defectdojo_regulation" "hipaa" {
+  acronym = "HIPAA"
+}
+
+data "defectdojo_regulation" "pci" {
+  acronym = "PCI DSS"
+}
+
+resource "defectdojo_product" "example" {
+  name            = "An example name"
+  description     = "An example description"
+  product_type_id = data.defectdojo_product_type.example.id
+  regulation_ids = [
+    data.defectdojo_regulation.hipaa.id,
+    data.defectdojo_regulation.pci.id,
+  ]
+}
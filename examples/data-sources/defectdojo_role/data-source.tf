@@ -0,0 +1,9 @@
+data "defectdojo_role" "reader" {
+  name = "Reader"
+}
+
+resource "defectdojo_product_member" "example" {
+  product_id = defectdojo_product.example.id
+  user_id    = defectdojo_user.jdoe.id
+  role_id    = data.defectdojo_role.reader.id
+}
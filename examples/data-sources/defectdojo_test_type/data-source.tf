@@ -0,0 +1,12 @@
+data "defectdojo_test_type" "zap" {
+  name = "ZAP Scan"
+}
+
+resource "defectdojo_test" "example" {
+  engagement_id  = defectdojo_engagement.example.id
+  test_type_id   = data.defectdojo_test_type.zap.id
+  environment_id = data.defectdojo_development_environment.production.id
+  title          = "Weekly ZAP scan"
+  target_start   = "2023-01-01T00:00:00Z"
+  target_end     = "2023-12-31T00:00:00Z"
+}
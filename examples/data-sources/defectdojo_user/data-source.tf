@@ -0,0 +1,3 @@
+data "defectdojo_user" "example" {
+  email = "jdoe@example.com"
+}
@@ -0,0 +1,2 @@
+# by the id of the development environment
+terraform import defectdojo_development_environment.example 4
@@ -0,0 +1,3 @@
+resource "defectdojo_development_environment" "example" {
+  name = "Pre-production"
+}
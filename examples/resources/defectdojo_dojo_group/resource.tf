@@ -0,0 +1,6 @@
+resource "defectdojo_dojo_group" "appsec" {
+  name            = "appsec"
+  description     = "Application Security team"
+  social_provider = "AzureAD"
+  global_role_id  = 5
+}
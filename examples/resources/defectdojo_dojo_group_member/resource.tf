@@ -0,0 +1,5 @@
+resource "defectdojo_dojo_group_member" "jdoe" {
+  group_id = defectdojo_dojo_group.appsec.id
+  user_id  = defectdojo_user.jdoe.id
+  role_id  = 2
+}
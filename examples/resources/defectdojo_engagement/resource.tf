@@ -0,0 +1,9 @@
+resource "defectdojo_engagement" "example" {
+  name            = "An example engagement"
+  product_id      = defectdojo_product.example.id
+  target_start    = "2023-01-01"
+  target_end      = "2023-12-31"
+  engagement_type = "CI/CD"
+  status          = "In Progress"
+  branch_tag      = "main"
+}
@@ -0,0 +1,15 @@
+resource "defectdojo_finding" "example" {
+  test_id           = defectdojo_test.example.id
+  title             = "SQL injection in the login form"
+  severity          = "High"
+  description       = "The username parameter of the login form is concatenated into a SQL query."
+  mitigation        = "Use prepared statements."
+  cwe               = 89
+  vulnerability_ids = ["CVE-2023-1234"]
+  cvssv3            = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"
+  component_name    = "login"
+  file_path         = "app/login.py"
+  line              = 42
+  verified          = true
+  tags              = ["pentest"]
+}
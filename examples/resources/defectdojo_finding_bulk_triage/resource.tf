@@ -0,0 +1,8 @@
+# the vulnerable code path isn't shipped in our base image
+resource "defectdojo_finding_bulk_triage" "example" {
+  vulnerability_id = "CVE-2023-1234"
+  component_name   = "openssl"
+  product_type_id  = defectdojo_product_type.example.id
+  out_of_scope     = true
+  active           = false
+}
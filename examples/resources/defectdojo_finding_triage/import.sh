@@ -0,0 +1,2 @@
+# by the id of the finding
+terraform import defectdojo_finding_triage.example 1234
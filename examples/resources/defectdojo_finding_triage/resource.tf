@@ -0,0 +1,17 @@
+# a Finding addressed by its id
+resource "defectdojo_finding_triage" "by_id" {
+  finding_id    = 1234
+  out_of_scope  = true
+  active        = false
+  justification = "The staging environment is only reachable from the VPN."
+}
+
+# a Finding of a scan, addressed by its hash code so that the triage survives reimports
+resource "defectdojo_finding_triage" "by_hash_code" {
+  product_id    = defectdojo_product.example.id
+  hash_code     = "0f7d2ce4d4ab0c1e59a1d3ef8e9a7c2b2a1e3e7c6d1f4b2a9c8e7d6f5a4b3c2d"
+  false_p       = true
+  active        = false
+  verified      = false
+  justification = "The query is built from constants, so it can't be injected."
+}
@@ -0,0 +1,2 @@
+# by the id of the jira finding mapping
+terraform import defectdojo_jira_finding_mapping.example 7
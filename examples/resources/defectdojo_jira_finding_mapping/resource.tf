@@ -0,0 +1,5 @@
+resource "defectdojo_jira_finding_mapping" "example" {
+  finding_id = 1234
+  jira_id    = "10042"
+  jira_key   = "APPSEC-42"
+}
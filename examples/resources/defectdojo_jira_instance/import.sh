@@ -0,0 +1,3 @@
+# by the id of the jira instance. The password is not imported and is
+# set again on the next apply.
+terraform import defectdojo_jira_instance.example 3
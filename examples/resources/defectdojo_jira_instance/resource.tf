@@ -0,0 +1,21 @@
+resource "defectdojo_jira_instance" "example" {
+  configuration_name = "Example Jira"
+  url                = "https://example.atlassian.net"
+  username           = "defectdojo@example.com"
+  password           = var.jira_api_token
+  default_issue_type = "Bug"
+
+  epic_name_id     = 10011
+  open_status_key  = 11
+  close_status_key = 41
+
+  info_mapping_severity     = "Lowest"
+  low_mapping_severity      = "Low"
+  medium_mapping_severity   = "Medium"
+  high_mapping_severity     = "High"
+  critical_mapping_severity = "Highest"
+
+  finding_text                      = "Reach out to #appsec with any questions."
+  accepted_mapping_resolution       = "Won't Fix"
+  false_positive_mapping_resolution = "Not a Bug"
+}
@@ -0,0 +1,14 @@
+resource "defectdojo_jira_product_configuration" "example" {
+  product_id       = defectdojo_product.example.id
+  jira_instance_id = defectdojo_jira_instance.example.id
+  project_key      = "APPSEC"
+  push_all_issues  = true
+
+  component        = "Security"
+  default_assignee = "jdoe"
+  jira_labels      = "security defectdojo"
+
+  custom_fields = jsonencode({
+    customfield_10001 = { value = "High" }
+  })
+}
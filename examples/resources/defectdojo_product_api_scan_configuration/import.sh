@@ -0,0 +1,2 @@
+# by the id of the product api scan configuration
+terraform import defectdojo_product_api_scan_configuration.example 11
@@ -0,0 +1,5 @@
+resource "defectdojo_product_api_scan_configuration" "example" {
+  product_id            = defectdojo_product.example.id
+  tool_configuration_id = defectdojo_tool_configuration.example.id
+  service_key_1         = "example-service"
+}
@@ -0,0 +1,5 @@
+# by the id of the membership
+terraform import defectdojo_product_group.example 42
+
+# or by <product_id>:<group_id>
+terraform import defectdojo_product_group.example 12:34
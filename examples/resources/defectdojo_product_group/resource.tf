@@ -0,0 +1,5 @@
+resource "defectdojo_product_group" "example" {
+  product_id = defectdojo_product.example.id
+  group_id   = defectdojo_dojo_group.appsec.id
+  role_id    = 5
+}
@@ -0,0 +1,5 @@
+# by the id of the membership
+terraform import defectdojo_product_member.example 42
+
+# or by <product_id>:<user_id>
+terraform import defectdojo_product_member.example 12:34
@@ -0,0 +1,5 @@
+resource "defectdojo_product_member" "example" {
+  product_id = defectdojo_product.example.id
+  user_id    = defectdojo_user.jdoe.id
+  role_id    = 5
+}
@@ -0,0 +1,2 @@
+# by the id of the product
+terraform import defectdojo_product_members.example 12
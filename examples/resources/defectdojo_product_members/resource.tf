@@ -0,0 +1,17 @@
+resource "defectdojo_product_members" "example" {
+  product_id = defectdojo_product.example.id
+
+  users = [
+    {
+      user_id = defectdojo_user.jdoe.id
+      role_id = 5
+    },
+  ]
+
+  groups = [
+    {
+      group_id = defectdojo_dojo_group.developers.id
+      role_id  = 2
+    },
+  ]
+}
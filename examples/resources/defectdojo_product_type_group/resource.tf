@@ -0,0 +1,5 @@
+resource "defectdojo_product_type_group" "example" {
+  product_type_id = defectdojo_product_type.example.id
+  group_id        = defectdojo_dojo_group.appsec.id
+  role_id         = 5
+}
@@ -0,0 +1,5 @@
+resource "defectdojo_product_type_member" "example" {
+  product_type_id = defectdojo_product_type.example.id
+  user_id         = defectdojo_user.jdoe.id
+  role_id         = 5
+}
@@ -0,0 +1,2 @@
+# by the id of the regulation
+terraform import defectdojo_regulation.example 12
@@ -0,0 +1,7 @@
+resource "defectdojo_regulation" "example" {
+  name         = "California Consumer Privacy Act"
+  acronym      = "CCPA"
+  category     = "privacy"
+  jurisdiction = "California"
+  reference    = "https://oag.ca.gov/privacy/ccpa"
+}
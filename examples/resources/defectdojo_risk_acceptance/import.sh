@@ -0,0 +1,2 @@
+# by the id of the risk acceptance
+terraform import defectdojo_risk_acceptance.example 2
@@ -0,0 +1,9 @@
+resource "defectdojo_risk_acceptance" "openssl" {
+  name                 = "Accepted until the base image is patched"
+  accepted_finding_ids = [defectdojo_finding.openssl.id]
+  owner_id             = 1
+  accepted_by          = "The CISO"
+  decision_details     = "The service is only reachable through the VPN"
+  expiration_date      = "2024-01-31T00:00:00Z"
+  proof_file           = "${path.module}/approvals/openssl.pdf"
+}
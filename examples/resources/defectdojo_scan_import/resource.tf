@@ -0,0 +1,8 @@
+resource "defectdojo_scan_import" "example" {
+  file               = "${path.module}/reports/trivy.json"
+  scan_type          = "Trivy Scan"
+  engagement_id      = defectdojo_engagement.example.id
+  minimum_severity   = "Low"
+  close_old_findings = true
+  branch_tag         = "main"
+}
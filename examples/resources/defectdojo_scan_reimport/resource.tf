@@ -0,0 +1,7 @@
+resource "defectdojo_scan_reimport" "nightly" {
+  test_id            = defectdojo_scan_import.example.test_id
+  file               = "${path.module}/reports/trivy.json"
+  scan_type          = "Trivy Scan"
+  do_not_reactivate  = true
+  close_old_findings = true
+}
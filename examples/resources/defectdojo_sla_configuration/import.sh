@@ -0,0 +1,2 @@
+# by the id of the sla configuration
+terraform import defectdojo_sla_configuration.example 2
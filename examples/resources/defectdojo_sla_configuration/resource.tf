@@ -0,0 +1,15 @@
+resource "defectdojo_sla_configuration" "example" {
+  name        = "Internet facing"
+  description = "Products reachable from the internet"
+  critical    = 7
+  high        = 30
+  medium      = 90
+  low         = 120
+}
+
+resource "defectdojo_product" "example" {
+  name                 = "An example name"
+  description          = "An example description"
+  product_type_id      = data.defectdojo_product_type.example.id
+  sla_configuration_id = defectdojo_sla_configuration.example.id
+}
@@ -0,0 +1,8 @@
+resource "defectdojo_test" "example" {
+  engagement_id = defectdojo_engagement.example.id
+  test_type_id  = 1
+  title         = "Nightly dependency scan"
+  target_start  = "2023-01-01T00:00:00Z"
+  target_end    = "2023-12-31T00:00:00Z"
+  branch_tag    = "main"
+}
@@ -0,0 +1,2 @@
+# by the id of the test type
+terraform import defectdojo_test_type.example 180
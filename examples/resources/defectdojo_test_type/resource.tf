@@ -0,0 +1,14 @@
+resource "defectdojo_test_type" "example" {
+  name        = "Internal Secrets Scanner"
+  static_tool = true
+  tags        = ["custom"]
+}
+
+resource "defectdojo_test" "example" {
+  engagement_id  = defectdojo_engagement.example.id
+  test_type_id   = defectdojo_test_type.example.id
+  environment_id = defectdojo_development_environment.example.id
+  title          = "Nightly secrets scan"
+  target_start   = "2023-01-01T00:00:00Z"
+  target_end     = "2023-12-31T00:00:00Z"
+}
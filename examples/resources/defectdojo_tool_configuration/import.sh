@@ -0,0 +1,3 @@
+# by the id of the tool configuration. The password, api key and ssh key are
+# not imported and are set again on the next apply.
+terraform import defectdojo_tool_configuration.example 5
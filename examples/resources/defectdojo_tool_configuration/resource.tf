@@ -0,0 +1,7 @@
+resource "defectdojo_tool_configuration" "example" {
+  name                = "SonarQube"
+  tool_type_id        = defectdojo_tool_type.example.id
+  url                 = "https://sonarqube.example.com/api"
+  authentication_type = "API"
+  api_key             = var.sonarqube_token
+}
@@ -0,0 +1,2 @@
+# by the id of the tool product settings
+terraform import defectdojo_tool_product_settings.example 8
@@ -0,0 +1,7 @@
+resource "defectdojo_tool_product_settings" "example" {
+  name                  = "SonarQube project"
+  product_id            = defectdojo_product.example.id
+  tool_configuration_id = defectdojo_tool_configuration.example.id
+  tool_project_id       = "example-service"
+  setting_url           = "https://sonarqube.example.com/dashboard?id=example-service"
+}
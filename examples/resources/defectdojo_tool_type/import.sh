@@ -0,0 +1,2 @@
+# by the id of the tool type
+terraform import defectdojo_tool_type.example 2
@@ -0,0 +1,4 @@
+resource "defectdojo_tool_type" "example" {
+  name        = "SonarQube"
+  description = "Static code analysis"
+}
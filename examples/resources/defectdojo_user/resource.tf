@@ -0,0 +1,14 @@
+resource "defectdojo_user" "jdoe" {
+  username       = "jdoe"
+  email          = "jdoe@example.com"
+  first_name     = "Jane"
+  last_name      = "Doe"
+  password       = var.initial_password
+  global_role_id = 5
+
+  contact_info {
+    title          = "Security Engineer"
+    slack_username = "jdoe@example.com"
+    slack_user_id  = "U0123456789"
+  }
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t developmentEnvironmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo Development Environment, looked up by its `name`. The `id` can be used as the `environment_id` of a `defectdojo_test`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Development Environment",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type developmentEnvironmentDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d developmentEnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_development_environment"
+}
+
+func NewDevelopmentEnvironmentDataSource() datasource.DataSource {
+	return &developmentEnvironmentDataSource{}
+}
+
+func (r *developmentEnvironmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d developmentEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// the data source exposes the same attributes as the resource
+	var data developmentEnvironmentResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the API can't filter on the name, so we go through all the pages and
+	// match it ourselves
+	matches := []dd.DevelopmentEnvironment{}
+	for offset := 0; ; offset += listPageSize {
+		apiResp, err := d.client.DevelopmentEnvironmentsListWithResponse(ctx, &dd.DevelopmentEnvironmentsListParams{
+			Limit:  ref.Of(listPageSize),
+			Offset: ref.Of(offset),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Retrieving Resource",
+				fmt.Sprintf("%s", err))
+			return
+		}
+
+		if apiResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error Retrieving Data Source",
+				fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+					fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+			)
+			return
+		}
+
+		if apiResp.JSON200.Results != nil {
+			for _, environment := range *apiResp.JSON200.Results {
+				if environment.Name == data.Name.ValueString() {
+					matches = append(matches, environment)
+				}
+			}
+		}
+
+		if apiResp.JSON200.Next == nil {
+			break
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			"No Development Environments matched the given parameters.")
+		return
+	} else if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			fmt.Sprintf("%d Development Environments matched the given parameters.", len(matches)))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%d", matches[0].Id))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
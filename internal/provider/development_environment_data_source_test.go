@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDevelopmentEnvironmentDataSource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-env-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_development_environment" "test" {
+  name = %q
+}
+data "defectdojo_development_environment" "test" {
+  name = defectdojo_development_environment.test.name
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_development_environment.test", "id", "defectdojo_development_environment.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDevelopmentEnvironmentDataSourceNoMatch(t *testing.T) {
+	name := fmt.Sprintf("dox-test-env-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Development Environments matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_development_environment" "test" {
+  name = %q
+}
+`, name),
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDevelopmentEnvironmentResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-env-%s", resource.UniqueId())
+	updatedName := fmt.Sprintf("dox-new-env-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDevelopmentEnvironmentResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_development_environment.test", "name", name),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_development_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDevelopmentEnvironmentResourceConfig(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_development_environment.test", "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDevelopmentEnvironmentResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDevelopmentEnvironmentResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_development_environment.test", "name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccDevelopmentEnvironmentResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_development_environment.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevelopmentEnvironmentResourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_development_environment" "test" {
+  name = %q
+}
+`, name)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestDevelopmentEnvironmentResource(t *testing.T) {
+	developmentEnvironmentResource := developmentEnvironmentResourceData{
+		Name: types.StringValue("Staging"),
+	}
+	var terraformResource terraformResourceData = &developmentEnvironmentResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, ddResource.(*developmentEnvironmentDefectdojoResource).Name, "Staging")
+
+	populateResourceData(context.Background(), &diags, &terraformResource, &developmentEnvironmentDefectdojoResource{
+		DevelopmentEnvironment: dd.DevelopmentEnvironment{Id: 7, Name: "Production"},
+	})
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, developmentEnvironmentResource.Id.ValueString(), "7")
+	assert.Equal(t, developmentEnvironmentResource.Name.ValueString(), "Production")
+}
+
+func TestDevelopmentEnvironmentResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &developmentEnvironmentDefectdojoResource{})
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t dojoGroupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Group Member. Adds a User to a Group.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Group",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the User",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role, which determines the permissions of the User to manage the Group",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type dojoGroupMemberResourceData struct {
+	GroupId types.Int64  `tfsdk:"group_id" ddField:"Group"`
+	UserId  types.Int64  `tfsdk:"user_id" ddField:"User"`
+	RoleId  types.Int64  `tfsdk:"role_id" ddField:"Role"`
+	Id      types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type dojoGroupMemberDefectdojoResource struct {
+	dd.DojoGroupMember
+}
+
+func (ddr *dojoGroupMemberDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.DojoGroupMembersCreateJSONRequestBody(ddr.DojoGroupMember)
+	apiResp, err := client.DojoGroupMembersCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.DojoGroupMember = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *dojoGroupMemberDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.DojoGroupMembersRetrieveWithResponse(ctx, idNumber, &dd.DojoGroupMembersRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.DojoGroupMember = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *dojoGroupMemberDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.DojoGroupMembersUpdateJSONRequestBody(ddr.DojoGroupMember)
+	apiResp, err := client.DojoGroupMembersUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.DojoGroupMember = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *dojoGroupMemberDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.DojoGroupMembersDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type dojoGroupMemberResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &dojoGroupMemberResource{}
+var _ resource.ResourceWithImportState = &dojoGroupMemberResource{}
+
+func NewDojoGroupMemberResource() resource.Resource {
+	return &dojoGroupMemberResource{
+		terraformResource: terraformResource{
+			dataProvider: dojoGroupMemberDataProvider{},
+		},
+	}
+}
+
+func (r dojoGroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dojo_group_member"
+}
+
+type dojoGroupMemberDataProvider struct{}
+
+func (r dojoGroupMemberDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data dojoGroupMemberResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *dojoGroupMemberResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *dojoGroupMemberResourceData) defectdojoResource() defectdojoResource {
+	return &dojoGroupMemberDefectdojoResource{
+		DojoGroupMember: dd.DojoGroupMember{},
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDojoGroupMemberResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-group-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDojoGroupMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_dojo_group_member.test", "group_id", "defectdojo_dojo_group.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_dojo_group_member.test", "user_id", "defectdojo_user.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_dojo_group_member.test", "role_id", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_dojo_group_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDojoGroupMemberResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group_member.test", "role_id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDojoGroupMemberResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDojoGroupMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group_member.test", "role_id", "5"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccDojoGroupMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_dojo_group_member.test"),
+				),
+			},
+			{
+				Config: testAccDojoGroupMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group_member.test", "role_id", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDojoGroupMemberResourceConfig(name string, roleId int) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_dojo_group" "test" {
+  name = %[1]q
+}
+resource "defectdojo_user" "test" {
+  username = %[1]q
+  email = "%[1]s@example.com"
+}
+resource "defectdojo_dojo_group_member" "test" {
+  group_id = defectdojo_dojo_group.test.id
+  user_id = defectdojo_user.test.id
+  role_id = %[2]d
+}
+`, name, roleId)
+}
@@ -0,0 +1,9 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestDojoGroupMemberResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &dojoGroupMemberDefectdojoResource{})
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t dojoGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Group. Groups are used to grant access to Products and Product Types to several Users at once.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Group",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Group",
+				Optional:            true,
+			},
+			"social_provider": schema.StringAttribute{
+				MarkdownDescription: "The social authentication provider the Group is synchronized with, so that membership follows the Group of the same name in the provider. Valid values are: 'AzureAD'",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AzureAD"),
+				},
+			},
+			"global_role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role the members of the Group have for all Product Types and Products",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type dojoGroupResourceData struct {
+	Name           types.String `tfsdk:"name" ddField:"Name"`
+	Description    types.String `tfsdk:"description" ddField:"Description"`
+	SocialProvider types.String `tfsdk:"social_provider" ddField:"SocialProvider"`
+	GlobalRoleId   types.Int64  `tfsdk:"global_role_id" ddField:"GlobalRole"`
+	Id             types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type dojoGroupDefectdojoResource struct {
+	dd.DojoGroup
+	// the client predates the social provider of groups, so we send and parse it ourselves
+	SocialProvider *string
+	// the global role lives in its own endpoint
+	GlobalRole *int
+}
+
+// dojoGroupBody is the request body for creating and updating a group, including the fields
+// that are missing from dd.DojoGroup.
+type dojoGroupBody struct {
+	Name           string  `json:"name"`
+	Description    *string `json:"description"`
+	SocialProvider *string `json:"social_provider"`
+}
+
+func (ddr *dojoGroupDefectdojoResource) requestBody() (*bytes.Reader, error) {
+	body, err := json.Marshal(dojoGroupBody{
+		Name:           ddr.Name,
+		Description:    ddr.Description,
+		SocialProvider: ddr.SocialProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (ddr *dojoGroupDefectdojoResource) setFromResponse(group dd.DojoGroup, body []byte) error {
+	var extra struct {
+		SocialProvider *string `json:"social_provider"`
+	}
+	if err := json.Unmarshal(body, &extra); err != nil {
+		return err
+	}
+	ddr.DojoGroup = group
+	ddr.SocialProvider = extra.SocialProvider
+	return nil
+}
+
+func (ddr *dojoGroupDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.DojoGroupsCreateWithBodyWithResponse(ctx, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		if err := ddr.setFromResponse(*apiResp.JSON201, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
+		if err := syncGlobalRole(ctx, client, dd.GlobalRole{Group: &ddr.Id, Role: ddr.GlobalRole}); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *dojoGroupDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.DojoGroupsRetrieveWithResponse(ctx, idNumber, &dd.DojoGroupsRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
+		ddr.GlobalRole, err = readGlobalRole(ctx, client, dd.GlobalRolesListParams{Group: &ddr.Id})
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *dojoGroupDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.DojoGroupsUpdateWithBodyWithResponse(ctx, idNumber, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
+		if err := syncGlobalRole(ctx, client, dd.GlobalRole{Group: &ddr.Id, Role: ddr.GlobalRole}); err != nil {
+			return 0, nil, err
+		}
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *dojoGroupDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.DojoGroupsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type dojoGroupResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &dojoGroupResource{}
+var _ resource.ResourceWithImportState = &dojoGroupResource{}
+
+func NewDojoGroupResource() resource.Resource {
+	return &dojoGroupResource{
+		terraformResource: terraformResource{
+			dataProvider: dojoGroupDataProvider{},
+		},
+	}
+}
+
+func (r dojoGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dojo_group"
+}
+
+type dojoGroupDataProvider struct{}
+
+func (r dojoGroupDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data dojoGroupResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *dojoGroupResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *dojoGroupResourceData) defectdojoResource() defectdojoResource {
+	return &dojoGroupDefectdojoResource{
+		DojoGroup: dd.DojoGroup{},
+	}
+}
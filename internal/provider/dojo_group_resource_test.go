@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDojoGroupResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-group-%s", resource.UniqueId())
+	updatedName := fmt.Sprintf("dox-new-group-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDojoGroupResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group.test", "name", name),
+					resource.TestCheckResourceAttr("defectdojo_dojo_group.test", "description", "test"),
+					resource.TestCheckResourceAttr("defectdojo_dojo_group.test", "global_role_id", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_dojo_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDojoGroupResourceMinimalConfig(updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group.test", "name", updatedName),
+					resource.TestCheckNoResourceAttr("defectdojo_dojo_group.test", "description"),
+					resource.TestCheckNoResourceAttr("defectdojo_dojo_group.test", "global_role_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDojoGroupResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDojoGroupResourceMinimalConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group.test", "name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccDojoGroupResourceMinimalConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_dojo_group.test"),
+				),
+			},
+			{
+				Config: testAccDojoGroupResourceMinimalConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_dojo_group.test", "name", name),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDojoGroupResourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_dojo_group" "test" {
+  name = %[1]q
+  description = "test"
+  global_role_id = 5
+}
+`, name)
+}
+
+func testAccDojoGroupResourceMinimalConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_dojo_group" "test" {
+  name = %[1]q
+}
+`, name)
+}
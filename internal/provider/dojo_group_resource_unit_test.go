@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"gotest.tools/assert"
+)
+
+func TestDojoGroupResource__requestBody(t *testing.T) {
+	ddGroup := dojoGroupDefectdojoResource{
+		DojoGroup: dd.DojoGroup{
+			Name: "A Group",
+		},
+		SocialProvider: ref.Of("AzureAD"),
+	}
+
+	reader, err := ddGroup.requestBody()
+	assert.NilError(t, err)
+	body, err := io.ReadAll(reader)
+	assert.NilError(t, err)
+
+	var sent map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	assert.Equal(t, sent["name"], "A Group")
+	assert.Equal(t, sent["social_provider"], "AzureAD")
+	// unset values are sent as null so that an update clears them
+	value, ok := sent["description"]
+	assert.Equal(t, ok, true)
+	assert.Assert(t, value == nil)
+}
+
+func TestDojoGroupResource__setFromResponse(t *testing.T) {
+	body := []byte(`{"id": 3, "name": "A Group", "description": null, "social_provider": "AzureAD", "users": [1]}`)
+	var group dd.DojoGroup
+	assert.NilError(t, json.Unmarshal(body, &group))
+
+	ddGroup := dojoGroupDefectdojoResource{}
+	assert.NilError(t, ddGroup.setFromResponse(group, body))
+	assert.Equal(t, ddGroup.Id, 3)
+	assert.Equal(t, ddGroup.Name, "A Group")
+	assert.Equal(t, *ddGroup.SocialProvider, "AzureAD")
+
+	// older versions of DefectDojo don't know about social providers
+	assert.NilError(t, ddGroup.setFromResponse(group, []byte(`{"id": 3, "name": "A Group"}`)))
+	assert.Assert(t, ddGroup.SocialProvider == nil)
+}
+
+func TestDojoGroupResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &dojoGroupDefectdojoResource{})
+}
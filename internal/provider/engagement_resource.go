@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t engagementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Engagement",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Engagement",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Engagement",
+				Optional:            true,
+			},
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product this Engagement belongs to",
+				Required:            true,
+			},
+			"lead_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the user who leads this Engagement",
+				Optional:            true,
+			},
+			"target_start": schema.StringAttribute{
+				MarkdownDescription: "The date the Engagement is planned to start, in YYYY-MM-DD format",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\A\d{4}-\d{2}-\d{2}\z`), "Must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"target_end": schema.StringAttribute{
+				MarkdownDescription: "The date the Engagement is planned to end, in YYYY-MM-DD format",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\A\d{4}-\d{2}-\d{2}\z`), "Must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"engagement_type": schema.StringAttribute{
+				MarkdownDescription: "The type of Engagement. Valid values are: 'Interactive', 'CI/CD'. Defaults to 'Interactive'.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Interactive", "CI/CD"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringDefault("Interactive"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the Engagement. Valid values are: 'Not Started', 'Blocked', 'Cancelled', 'Completed', 'In Progress', 'On Hold', 'Waiting for Resource'",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Not Started", "Blocked", "Cancelled", "Completed", "In Progress", "On Hold", "Waiting for Resource"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the product the Engagement tested",
+				Optional:            true,
+			},
+			"build_id": schema.StringAttribute{
+				MarkdownDescription: "Build ID of the product the Engagement tested",
+				Optional:            true,
+			},
+			"commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Commit hash from the repository",
+				Optional:            true,
+			},
+			"branch_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag or branch of the product the Engagement tested",
+				Optional:            true,
+			},
+			"source_code_management_uri": schema.StringAttribute{
+				MarkdownDescription: "Resource link to the source code",
+				Optional:            true,
+			},
+			"deduplication_on_engagement": schema.BoolAttribute{
+				MarkdownDescription: "If enabled, deduplication will only mark a finding in this Engagement as a duplicate of another finding if both findings are in this Engagement. If disabled, deduplication is on the product level.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(false),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply to the Engagement",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[a-z]+\z`), "Tags must be lower case values"),
+					),
+				},
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type engagementResourceData struct {
+	Name                      types.String `tfsdk:"name" ddField:"Name"`
+	Description               types.String `tfsdk:"description" ddField:"Description"`
+	ProductId                 types.Int64  `tfsdk:"product_id" ddField:"Product"`
+	LeadId                    types.Int64  `tfsdk:"lead_id" ddField:"Lead"`
+	TargetStart               types.String `tfsdk:"target_start" ddField:"TargetStart"`
+	TargetEnd                 types.String `tfsdk:"target_end" ddField:"TargetEnd"`
+	EngagementType            types.String `tfsdk:"engagement_type" ddField:"EngagementType"`
+	Status                    types.String `tfsdk:"status" ddField:"Status"`
+	Version                   types.String `tfsdk:"version" ddField:"Version"`
+	BuildId                   types.String `tfsdk:"build_id" ddField:"BuildId"`
+	CommitHash                types.String `tfsdk:"commit_hash" ddField:"CommitHash"`
+	BranchTag                 types.String `tfsdk:"branch_tag" ddField:"BranchTag"`
+	SourceCodeManagementUri   types.String `tfsdk:"source_code_management_uri" ddField:"SourceCodeManagementUri"`
+	DeduplicationOnEngagement types.Bool   `tfsdk:"deduplication_on_engagement" ddField:"DeduplicationOnEngagement"`
+	Tags                      types.Set    `tfsdk:"tags" ddField:"Tags"`
+	Id                        types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type engagementDefectdojoResource struct {
+	dd.Engagement
+}
+
+type engagementBody struct {
+	dd.Engagement
+	// the client sends an unset engagement type as null, which DefectDojo stores instead of its default
+	EngagementType *dd.EngagementEngagementType `json:"engagement_type,omitempty"`
+}
+
+func (ddr *engagementDefectdojoResource) requestBody() (*bytes.Reader, error) {
+	body, err := json.Marshal(engagementBody{
+		Engagement:     ddr.Engagement,
+		EngagementType: ddr.EngagementType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (ddr *engagementDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.EngagementsCreateWithBodyWithResponse(ctx, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.Engagement = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *engagementDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.EngagementsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.Engagement = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *engagementDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.EngagementsUpdateWithBodyWithResponse(ctx, idNumber, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.Engagement = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *engagementDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.EngagementsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type engagementResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &engagementResource{}
+var _ resource.ResourceWithImportState = &engagementResource{}
+
+func NewEngagementResource() resource.Resource {
+	return &engagementResource{
+		terraformResource: terraformResource{
+			dataProvider: engagementDataProvider{},
+		},
+	}
+}
+
+func (r engagementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_engagement"
+}
+
+type engagementDataProvider struct{}
+
+func (r engagementDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data engagementResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *engagementResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *engagementResourceData) defectdojoResource() defectdojoResource {
+	return &engagementDefectdojoResource{
+		Engagement: dd.Engagement{},
+	}
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEngagementResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	name := fmt.Sprintf("dox-test-engagement-%s", resource.UniqueId())
+	updatedName := fmt.Sprintf("dox-new-engagement-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEngagementResourceConfig(productName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "name", name),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "description", "test"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "target_start", "2023-01-01"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "target_end", "2023-12-31"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "engagement_type", "CI/CD"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "status", "In Progress"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "version", "1.0.0"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "build_id", "42"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "commit_hash", "abc123"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "branch_tag", "main"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "source_code_management_uri", "https://github.com/doximity/terraform-provider-defectdojo"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "deduplication_on_engagement", "true"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "tags.0", "bar"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "tags.1", "foo"),
+					resource.TestCheckResourceAttrPair("defectdojo_engagement.test", "product_id", "defectdojo_product.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_engagement.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccEngagementResourceMinimalConfig(productName, updatedName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "name", updatedName),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "target_start", "2023-02-01"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "target_end", "2023-02-28"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "engagement_type", "Interactive"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "deduplication_on_engagement", "false"),
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "tags.#", "0"),
+					resource.TestCheckNoResourceAttr("defectdojo_engagement.test", "description"),
+					resource.TestCheckNoResourceAttr("defectdojo_engagement.test", "version"),
+					resource.TestCheckNoResourceAttr("defectdojo_engagement.test", "build_id"),
+					resource.TestCheckNoResourceAttr("defectdojo_engagement.test", "commit_hash"),
+					resource.TestCheckNoResourceAttr("defectdojo_engagement.test", "branch_tag"),
+					resource.TestCheckNoResourceAttr("defectdojo_engagement.test", "source_code_management_uri"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEngagementResourceDeleteDrift(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEngagementResourceMinimalConfig(productName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccEngagementResourceMinimalConfig(productName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_engagement.test"),
+				),
+			},
+			{
+				Config: testAccEngagementResourceMinimalConfig(productName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_engagement.test", "name", name),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccEngagementResourceInvalid(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	name := fmt.Sprintf("dox-invalid-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`.*Invalid\s+Attribute.*`),
+				Config:      testAccEngagementResourceInvalidConfig(productName, name),
+			},
+		},
+	})
+}
+
+func testAccEngagementResourceConfig(productName string, name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[2]q
+  description = "test"
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+  engagement_type = "CI/CD"
+  status = "In Progress"
+  version = "1.0.0"
+  build_id = "42"
+  commit_hash = "abc123"
+  branch_tag = "main"
+  source_code_management_uri = "https://github.com/doximity/terraform-provider-defectdojo"
+  deduplication_on_engagement = true
+  tags = ["foo", "bar"]
+}
+`, productName, name)
+}
+
+func testAccEngagementResourceMinimalConfig(productName string, name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[2]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-02-01"
+  target_end = "2023-02-28"
+}
+`, productName, name)
+}
+
+func testAccEngagementResourceInvalidConfig(productName string, name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[2]q
+  product_id = defectdojo_product.test.id
+  target_start = "01/02/2023"
+  target_end = "2023-02-28"
+  engagement_type = "Something Else"
+}
+`, productName, name)
+}
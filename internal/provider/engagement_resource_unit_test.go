@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestEngagementResourcePopulate(t *testing.T) {
+	expectedName := "An Engagement"
+	expectedEngagementType := "CI/CD"
+	expectedStatus := "In Progress"
+	expectedDeduplication := true
+	expectedProductId := 42
+	expectedLeadId := 43
+
+	ddEngagement := engagementDefectdojoResource{
+		Engagement: dd.Engagement{
+			Id:                        99,
+			Name:                      &expectedName,
+			Product:                   expectedProductId,
+			Lead:                      &expectedLeadId,
+			TargetStart:               openapi_types.Date{Time: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+			TargetEnd:                 openapi_types.Date{Time: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)},
+			EngagementType:            (*dd.EngagementEngagementType)(&expectedEngagementType),
+			Status:                    (*dd.EngagementStatus)(&expectedStatus),
+			DeduplicationOnEngagement: &expectedDeduplication,
+		},
+	}
+
+	engagementResource := engagementResourceData{}
+	var terraformResource terraformResourceData = &engagementResource
+
+	populateResourceData(context.Background(), &diag.Diagnostics{}, &terraformResource, &ddEngagement)
+	assert.Equal(t, engagementResource.Id.ValueString(), "99")
+	assert.Equal(t, engagementResource.Name.ValueString(), expectedName)
+	assert.Equal(t, engagementResource.ProductId.ValueInt64(), (int64)(expectedProductId))
+	assert.Equal(t, engagementResource.LeadId.ValueInt64(), (int64)(expectedLeadId))
+	assert.Equal(t, engagementResource.TargetStart.ValueString(), "2023-01-02")
+	assert.Equal(t, engagementResource.TargetEnd.ValueString(), "2023-12-31")
+	assert.Equal(t, engagementResource.EngagementType.ValueString(), expectedEngagementType)
+	assert.Equal(t, engagementResource.Status.ValueString(), expectedStatus)
+	assert.Equal(t, engagementResource.DeduplicationOnEngagement.ValueBool(), expectedDeduplication)
+	assert.Equal(t, engagementResource.BuildId.IsNull(), true)
+	assert.Equal(t, engagementResource.CommitHash.IsNull(), true)
+	assert.Equal(t, engagementResource.BranchTag.IsNull(), true)
+}
+
+func TestEngagementResource__defectdojoResource(t *testing.T) {
+	engagementResource := engagementResourceData{
+		Name:           types.StringValue("An Engagement"),
+		ProductId:      types.Int64Value(42),
+		TargetStart:    types.StringValue("2023-01-02"),
+		TargetEnd:      types.StringValue("2023-12-31"),
+		EngagementType: types.StringValue("Interactive"),
+		Status:         types.StringUnknown(),
+		CommitHash:     types.StringValue("abc123"),
+		Tags:           types.SetNull(types.StringType),
+	}
+
+	ddResource := engagementResource.defectdojoResource()
+	ddEngagement := ddResource.(*engagementDefectdojoResource)
+	var terraformResource terraformResourceData = &engagementResource
+	diags := diag.Diagnostics{}
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+
+	var nilStatus *dd.EngagementStatus
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, *ddEngagement.Name, "An Engagement")
+	assert.Equal(t, ddEngagement.Product, 42)
+	assert.Equal(t, ddEngagement.TargetStart.String(), "2023-01-02")
+	assert.Equal(t, ddEngagement.TargetEnd.String(), "2023-12-31")
+	assert.Equal(t, (string)(*ddEngagement.EngagementType), "Interactive")
+	assert.Equal(t, ddEngagement.Status, nilStatus)
+	assert.Equal(t, *ddEngagement.CommitHash, "abc123")
+	assert.DeepEqual(t, *ddEngagement.Tags, []string{})
+}
+
+func TestEngagementResource__defectdojoResource_InvalidDate(t *testing.T) {
+	engagementResource := engagementResourceData{
+		TargetStart: types.StringValue("01/02/2023"),
+		TargetEnd:   types.StringValue("2023-12-31"),
+		Tags:        types.SetNull(types.StringType),
+	}
+
+	ddResource := engagementResource.defectdojoResource()
+	var terraformResource terraformResourceData = &engagementResource
+	diags := diag.Diagnostics{}
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+
+	assert.Equal(t, diags.HasError(), true)
+}
+
+func TestEngagementResource__requestBody(t *testing.T) {
+	ddEngagement := engagementDefectdojoResource{
+		Engagement: dd.Engagement{
+			Name:    ref.Of("An Engagement"),
+			Product: 42,
+		},
+	}
+
+	reqBody, err := ddEngagement.requestBody()
+	assert.NilError(t, err)
+	var sent map[string]interface{}
+	assert.NilError(t, json.NewDecoder(reqBody).Decode(&sent))
+	assert.Equal(t, sent["name"], "An Engagement")
+	// an unset engagement type is left to DefectDojo's default
+	_, ok := sent["engagement_type"]
+	assert.Equal(t, ok, false)
+
+	engagementType := dd.EngagementEngagementType("CI/CD")
+	ddEngagement.EngagementType = &engagementType
+	reqBody, err = ddEngagement.requestBody()
+	assert.NilError(t, err)
+	sent = map[string]interface{}{}
+	assert.NilError(t, json.NewDecoder(reqBody).Decode(&sent))
+	assert.Equal(t, sent["engagement_type"], "CI/CD")
+}
+
+func TestEngagementResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &engagementDefectdojoResource{})
+}
@@ -0,0 +1,515 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t findingBulkTriageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	flagAttribute := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description + " Left as it is when not set.",
+			Optional:            true,
+		}
+	}
+	activeAttribute := flagAttribute("Whether the Findings are active.")
+	activeAttribute.Validators = []validator.Bool{
+		boolvalidator.AtLeastOneOf(
+			path.MatchRoot("verified"),
+			path.MatchRoot("false_p"),
+			path.MatchRoot("out_of_scope"),
+			path.MatchRoot("under_review"),
+			path.MatchRoot("is_mitigated"),
+		),
+	}
+
+	touchedFindingAttributes := map[string]schema.Attribute{
+		"finding_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the Finding",
+			Computed:            true,
+		},
+	}
+	for name := range findingTriageFlagAttrTypes {
+		touchedFindingAttributes[name] = schema.BoolAttribute{
+			MarkdownDescription: "The value of the flag before the triage, or null when the triage never set it",
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The triage of every DefectDojo Finding matching a set of filters, for example to mark a vulnerability that isn't exploitable in a base image as out of scope across all Products. Only the flags that are set are managed. Every apply also triages the Findings that started matching the filters since the last one, and a flag shows up as drift as long as a matching Finding doesn't have it. The Findings that were changed are recorded in `touched_findings`, and destroying this resource restores the flags that are set to the values they had before.\n\nChanging the filters replaces the resource, which restores the Findings matched by the old filters before triaging the ones matched by the new filters. This resource can't be imported.",
+
+		Attributes: map[string]schema.Attribute{
+			"vulnerability_id": schema.StringAttribute{
+				MarkdownDescription: "Only triage the Findings with this vulnerability id, such as a CVE",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(
+						path.MatchRoot("component_name"),
+						path.MatchRoot("component_version"),
+						path.MatchRoot("product_id"),
+						path.MatchRoot("product_type_id"),
+						path.MatchRoot("tags"),
+						path.MatchRoot("severity"),
+					),
+				},
+			},
+			"component_name": schema.StringAttribute{
+				MarkdownDescription: "Only triage the Findings in the component with this name",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"component_version": schema.StringAttribute{
+				MarkdownDescription: "Only triage the Findings in the component with this version",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "Only triage the Findings of the Product with this ID",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"product_type_id": schema.Int64Attribute{
+				MarkdownDescription: "Only triage the Findings of the Products of the Product Type with this ID",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only triage the Findings with any of these tags",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[^\s,]+\z`), "Tags can't contain spaces or commas"),
+					),
+				},
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Only triage the Findings with this severity. One of `Critical`, `High`, `Medium`, `Low` or `Info`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Critical", "High", "Medium", "Low", "Info"),
+				},
+			},
+			"active":       activeAttribute,
+			"verified":     flagAttribute("Whether the Findings have been verified."),
+			"false_p":      flagAttribute("Whether the Findings are false positives."),
+			"out_of_scope": flagAttribute("Whether the Findings are out of scope."),
+			"under_review": flagAttribute("Whether the Findings are under review."),
+			"is_mitigated": flagAttribute("Whether the Findings have been mitigated."),
+			"touched_findings": schema.SetNestedAttribute{
+				MarkdownDescription: "The Findings the triage changed, with the flags they had before, which are restored when this resource is destroyed",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: touchedFindingAttributes,
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, which is the time the triage was created at, as nothing in DefectDojo backs it",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type findingBulkTriageResourceData struct {
+	VulnerabilityId  types.String `tfsdk:"vulnerability_id" ddField:"VulnerabilityId"`
+	ComponentName    types.String `tfsdk:"component_name" ddField:"ComponentName"`
+	ComponentVersion types.String `tfsdk:"component_version" ddField:"ComponentVersion"`
+	ProductId        types.Int64  `tfsdk:"product_id" ddField:"ProductId"`
+	ProductTypeId    types.Int64  `tfsdk:"product_type_id" ddField:"ProductTypeId"`
+	Tags             types.Set    `tfsdk:"tags" ddField:"Tags"`
+	Severity         types.String `tfsdk:"severity" ddField:"Severity"`
+	Active           types.Bool   `tfsdk:"active" ddField:"Active"`
+	Verified         types.Bool   `tfsdk:"verified" ddField:"Verified"`
+	FalseP           types.Bool   `tfsdk:"false_p" ddField:"FalseP"`
+	OutOfScope       types.Bool   `tfsdk:"out_of_scope" ddField:"OutOfScope"`
+	UnderReview      types.Bool   `tfsdk:"under_review" ddField:"UnderReview"`
+	IsMitigated      types.Bool   `tfsdk:"is_mitigated" ddField:"IsMitigated"`
+	TouchedFindings  types.Set    `tfsdk:"touched_findings" ddField:"TouchedFindings"`
+	Id               types.String `tfsdk:"id" ddField:"Id"`
+}
+
+var findingBulkTriageTouchedFindingAttrTypes = map[string]attr.Type{
+	"finding_id":   types.Int64Type,
+	"active":       types.BoolType,
+	"verified":     types.BoolType,
+	"false_p":      types.BoolType,
+	"out_of_scope": types.BoolType,
+	"under_review": types.BoolType,
+	"is_mitigated": types.BoolType,
+}
+
+// only returns the flags that are also set in other.
+func (flags findingTriageFlags) only(other findingTriageFlags) findingTriageFlags {
+	otherFields := other.fields()
+	for i, flag := range flags.fields() {
+		if *otherFields[i] == nil {
+			*flag = nil
+		}
+	}
+	return flags
+}
+
+// without returns the flags that aren't set in other.
+func (flags findingTriageFlags) without(other findingTriageFlags) findingTriageFlags {
+	otherFields := other.fields()
+	for i, flag := range flags.fields() {
+		if *otherFields[i] != nil {
+			*flag = nil
+		}
+	}
+	return flags
+}
+
+// merge returns the flags, with the ones that aren't set taken from other.
+func (flags findingTriageFlags) merge(other findingTriageFlags) findingTriageFlags {
+	otherFields := other.fields()
+	for i, flag := range flags.fields() {
+		if *flag == nil {
+			*flag = *otherFields[i]
+		}
+	}
+	return flags
+}
+
+// appliedTo tells whether the Finding has all the flags that are set.
+func (flags findingTriageFlags) appliedTo(finding dd.Finding) bool {
+	current := findingTriageFlagsOf(finding)
+	currentFields := current.fields()
+	for i, desired := range flags.fields() {
+		if *desired != nil && **desired != (*currentFields[i] != nil && **currentFields[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+type findingBulkTriageDefectdojoResource struct {
+	findingTriageFlags
+	Id               int
+	VulnerabilityId  *string
+	ComponentName    *string
+	ComponentVersion *string
+	ProductId        *int
+	ProductTypeId    *int
+	Tags             *[]string
+	Severity         *string
+	TouchedFindings  types.Set
+}
+
+// matchingFindings returns all the Findings matching the filters.
+func (ddr *findingBulkTriageDefectdojoResource) matchingFindings(ctx context.Context, client *dd.ClientWithResponses) ([]dd.Finding, error) {
+	filters := url.Values{}
+	if ddr.VulnerabilityId != nil {
+		filters.Set("vulnerability_id", *ddr.VulnerabilityId)
+	}
+	if ddr.ComponentName != nil {
+		filters.Set("component_name", *ddr.ComponentName)
+	}
+	if ddr.ComponentVersion != nil {
+		filters.Set("component_version", *ddr.ComponentVersion)
+	}
+	if ddr.ProductId != nil {
+		filters.Set("test__engagement__product", strconv.Itoa(*ddr.ProductId))
+	}
+	if ddr.ProductTypeId != nil {
+		filters.Set("test__engagement__product__prod_type", strconv.Itoa(*ddr.ProductTypeId))
+	}
+	if ddr.Tags != nil && len(*ddr.Tags) > 0 {
+		filters.Set("tags", strings.Join(*ddr.Tags, ","))
+	}
+	if ddr.Severity != nil {
+		filters.Set("severity", *ddr.Severity)
+	}
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("At least one filter is required, so that not every Finding is triaged")
+	}
+	// the product type of each Finding is needed to check it against the filters
+	filters.Set("related_fields", "true")
+
+	listed, err := listFindings(ctx, client, filters)
+	if err != nil {
+		return nil, err
+	}
+	findings := []dd.Finding{}
+	for _, finding := range listed {
+		if err := ddr.checkFilters(finding); err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding.Finding)
+	}
+	return findings, nil
+}
+
+// checkFilters makes sure the server applied the filters to a Finding it listed. Older versions of
+// DefectDojo don't know about the vulnerability id and product type filters, and ignore them rather
+// than failing, which would have the triage change Findings it wasn't meant to.
+func (ddr *findingBulkTriageDefectdojoResource) checkFilters(finding findingDefectdojoResource) error {
+	unsupported := func(filter string) error {
+		return fmt.Errorf("The Finding %d doesn't match the %s filter, which this version of DefectDojo doesn't seem to support. No Findings were triaged.", finding.Id, filter)
+	}
+
+	if ddr.VulnerabilityId != nil {
+		found := finding.Cve != nil && *finding.Cve == *ddr.VulnerabilityId
+		if finding.VulnerabilityIds != nil {
+			for _, vulnerabilityId := range *finding.VulnerabilityIds {
+				found = found || vulnerabilityId == *ddr.VulnerabilityId
+			}
+		}
+		if !found {
+			return unsupported("vulnerability_id")
+		}
+	}
+
+	if ddr.ProductTypeId != nil {
+		engagement := finding.RelatedFields.Test.Engagement
+		if engagement == nil || engagement.Product == nil || engagement.Product.ProdType == nil || engagement.Product.ProdType.Id != *ddr.ProductTypeId {
+			return unsupported("product_type_id")
+		}
+	}
+
+	return nil
+}
+
+// touchedFindings returns the flags the touched Findings had before the triage, by their ID.
+func (ddr *findingBulkTriageDefectdojoResource) touchedFindings() map[int]findingTriageFlags {
+	touched := map[int]findingTriageFlags{}
+	if ddr.TouchedFindings.IsNull() || ddr.TouchedFindings.IsUnknown() {
+		return touched
+	}
+	for _, element := range ddr.TouchedFindings.Elements() {
+		attributes := element.(types.Object).Attributes()
+		findingId := int(attributes["finding_id"].(types.Int64).ValueInt64())
+		flags := map[string]attr.Value{}
+		for name := range findingTriageFlagAttrTypes {
+			flags[name] = attributes[name]
+		}
+		touched[findingId] = findingTriageFlagsFromObject(types.ObjectValueMust(findingTriageFlagAttrTypes, flags))
+	}
+	return touched
+}
+
+func (ddr *findingBulkTriageDefectdojoResource) setTouchedFindings(touched map[int]findingTriageFlags) {
+	elements := []attr.Value{}
+	for findingId, flags := range touched {
+		attributes := flags.object().Attributes()
+		attributes["finding_id"] = types.Int64Value(int64(findingId))
+		elements = append(elements, types.ObjectValueMust(findingBulkTriageTouchedFindingAttrTypes, attributes))
+	}
+	ddr.TouchedFindings = types.SetValueMust(types.ObjectType{AttrTypes: findingBulkTriageTouchedFindingAttrTypes}, elements)
+}
+
+// apply sets the flags of every matching Finding that doesn't have them yet, recording the value each
+// flag had before the first time it is set on the Finding. When a Finding can't be triaged, the flags
+// recorded for the first time are restored, since they wouldn't be saved to the state.
+func (ddr *findingBulkTriageDefectdojoResource) apply(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	findings, err := ddr.matchingFindings(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	touched := ddr.touchedFindings()
+	newlyTouched := map[int]findingTriageFlags{}
+	restore := func() {
+		for findingId, flags := range newlyTouched {
+			// this is a best effort, the error that got us here is the one worth reporting
+			_, _ = patchFindingFlags(ctx, client, findingId, flags)
+		}
+	}
+	for _, finding := range findings {
+		if ddr.findingTriageFlags.appliedTo(finding) {
+			continue
+		}
+		apiResp, err := patchFindingFlags(ctx, client, finding.Id, ddr.findingTriageFlags)
+		if err != nil {
+			restore()
+			return 0, nil, err
+		}
+		if apiResp.JSON200 == nil {
+			restore()
+			return apiResp.StatusCode(), apiResp.Body, nil
+		}
+		newlyRecorded := ddr.findingTriageFlags.without(touched[finding.Id]).previous(finding)
+		if newlyRecorded != (findingTriageFlags{}) {
+			touched[finding.Id] = touched[finding.Id].merge(newlyRecorded)
+			newlyTouched[finding.Id] = newlyRecorded
+		}
+	}
+	ddr.setTouchedFindings(touched)
+
+	return 200, nil, nil
+}
+
+func (ddr *findingBulkTriageDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	statusCode, body, err := ddr.apply(ctx, client)
+	if err != nil || statusCode != 200 {
+		return statusCode, body, err
+	}
+	ddr.Id = int(time.Now().Unix())
+
+	return 201, nil, nil
+}
+
+func (ddr *findingBulkTriageDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	findings, err := ddr.matchingFindings(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// a flag that some matching Finding doesn't have is reported as its opposite, so that the
+	// next apply triages that Finding
+	desired := ddr.findingTriageFlags
+	reported := ddr.findingTriageFlags
+	reportedFields := reported.fields()
+	for _, finding := range findings {
+		current := findingTriageFlagsOf(finding)
+		currentFields := current.fields()
+		for i, flag := range desired.fields() {
+			if *flag != nil && **flag != (*currentFields[i] != nil && **currentFields[i]) {
+				*reportedFields[i] = ref.Of(!**flag)
+			}
+		}
+	}
+	ddr.findingTriageFlags = reported
+	ddr.Id = idNumber
+
+	return 200, nil, nil
+}
+
+func (ddr *findingBulkTriageDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	ddr.Id = idNumber
+	return ddr.apply(ctx, client)
+}
+
+func (ddr *findingBulkTriageDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	for findingId, flags := range ddr.touchedFindings() {
+		// a flag that is no longer set isn't managed anymore, so it is left as it is
+		flags = flags.only(ddr.findingTriageFlags)
+		if flags == (findingTriageFlags{}) {
+			continue
+		}
+		apiResp, err := patchFindingFlags(ctx, client, findingId, flags)
+		if err != nil {
+			return 0, nil, err
+		}
+		// a Finding that has been deleted since has nothing to restore
+		if apiResp.JSON200 == nil && apiResp.StatusCode() != 404 {
+			return apiResp.StatusCode(), apiResp.Body, nil
+		}
+	}
+
+	return 204, nil, nil
+}
+
+type findingBulkTriageResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &findingBulkTriageResource{}
+
+func NewFindingBulkTriageResource() resource.Resource {
+	return &findingBulkTriageResource{
+		terraformResource: terraformResource{
+			dataProvider: findingBulkTriageDataProvider{},
+		},
+	}
+}
+
+func (r findingBulkTriageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_finding_bulk_triage"
+}
+
+func (r findingBulkTriageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// the touched findings are unknown in the plan, since newly matching findings are added to
+	// them, but the ones touched so far have to be kept
+	var touched types.Set
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("touched_findings"), &touched)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("touched_findings"), touched)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.terraformResource.Update(ctx, req, resp)
+}
+
+func (r findingBulkTriageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"Resource Import Not Supported",
+		"A defectdojo_finding_bulk_triage can't be imported, since the flags the Findings had before the triage are unknown.")
+}
+
+type findingBulkTriageDataProvider struct{}
+
+func (r findingBulkTriageDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data findingBulkTriageResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *findingBulkTriageResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *findingBulkTriageResourceData) defectdojoResource() defectdojoResource {
+	// the delete is only given what is set here, which are the Findings to restore and the flags that
+	// are restored on them
+	return &findingBulkTriageDefectdojoResource{
+		findingTriageFlags: findingTriageFlags{
+			Active:      boolPointer(d.Active),
+			Verified:    boolPointer(d.Verified),
+			FalseP:      boolPointer(d.FalseP),
+			OutOfScope:  boolPointer(d.OutOfScope),
+			UnderReview: boolPointer(d.UnderReview),
+			IsMitigated: boolPointer(d.IsMitigated),
+		},
+		TouchedFindings: d.TouchedFindings,
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFindingBulkTriageResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-finding-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFindingBulkTriageResourceConfig(productName, title, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding_bulk_triage.test", "touched_findings.#", "1"),
+					resource.TestCheckResourceAttrPair("defectdojo_finding_bulk_triage.test", "touched_findings.0.finding_id", "defectdojo_finding.test.0", "id"),
+					resource.TestCheckResourceAttr("defectdojo_finding_bulk_triage.test", "touched_findings.0.active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding_bulk_triage.test", "touched_findings.0.out_of_scope", "false"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding_bulk_triage.test", "touched_findings.0.verified"),
+					resource.TestCheckResourceAttr("defectdojo_finding_bulk_triage.test", "out_of_scope", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding_bulk_triage.test", "active", "false"),
+				),
+			},
+			// A newly matching finding shows up as drift...
+			{
+				Config:             testAccFindingBulkTriageResourceConfig(productName, title, 2),
+				ExpectNonEmptyPlan: true,
+			},
+			// ...and is triaged by the next apply
+			{
+				Config: testAccFindingBulkTriageResourceConfig(productName, title, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding_bulk_triage.test", "touched_findings.#", "2"),
+				),
+			},
+			// Destroying the triage restores the flags of the Findings
+			{
+				Config: testAccFindingBulkTriageResourceFindingsConfig(productName, title, 2),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test.0", "out_of_scope", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test.0", "active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test.1", "out_of_scope", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test.1", "active", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFindingBulkTriageResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "defectdojo" {}
+resource "defectdojo_finding_bulk_triage" "test" {
+  out_of_scope = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccFindingBulkTriageResourceFindingsConfig(productName string, title string, count int) string {
+	return testAccFindingResourceTestConfig(productName) + fmt.Sprintf(`
+resource "defectdojo_finding" "test" {
+  count = %[2]d
+  test_id = defectdojo_test.test.id
+  title = "%[1]s-${count.index}"
+  severity = "Medium"
+  description = "A vulnerable base image package"
+  vulnerability_ids = ["CVE-2023-1234"]
+  component_name = "openssl"
+}
+`, title, count)
+}
+
+func testAccFindingBulkTriageResourceConfig(productName string, title string, count int) string {
+	return testAccFindingBulkTriageResourceFindingsConfig(productName, title, count) + `
+resource "defectdojo_finding_bulk_triage" "test" {
+  vulnerability_id = "CVE-2023-1234"
+  product_id = defectdojo_product.test.id
+  out_of_scope = true
+  active = false
+
+  depends_on = [defectdojo_finding.test]
+}
+`
+}
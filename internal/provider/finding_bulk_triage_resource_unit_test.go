@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+// findingBulkTriageServer serves the given findings in pages of one, and records the flags patched
+// on each of them.
+func findingBulkTriageServer(t *testing.T, findings []string, patched map[string]map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			assert.Equal(t, r.URL.Path, "/api/v2/findings/")
+			assert.Equal(t, r.URL.Query().Get("vulnerability_id"), "CVE-2023-1234")
+			assert.Equal(t, r.URL.Query().Get("test__engagement__product__prod_type"), "2")
+			assert.Equal(t, r.URL.Query().Get("related_fields"), "true")
+			assert.Equal(t, r.URL.Query().Get("limit"), "100")
+
+			// the page is chosen by the offset, to make sure we go through all of them
+			var offset int
+			fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+			page := offset / listPageSize
+			next := "null"
+			if page+1 < len(findings) {
+				next = fmt.Sprintf(`"%s/api/v2/findings/?offset=%d"`, r.Host, offset+listPageSize)
+			}
+			fmt.Fprintf(w, `{"count": %d, "next": %s, "results": [%s]}`, len(findings), next, findings[page])
+			return
+		}
+
+		assert.Equal(t, r.Method, http.MethodPatch)
+		body, err := io.ReadAll(r.Body)
+		assert.NilError(t, err)
+		var patch map[string]interface{}
+		assert.NilError(t, json.Unmarshal(body, &patch))
+		patched[r.URL.Path] = patch
+		w.Write([]byte(`{"id": 1}`))
+	}))
+}
+
+// bulkTriageFinding is a Finding with the given flags that matches the filters of the tests.
+func bulkTriageFinding(id int, flags string) string {
+	return fmt.Sprintf(`{"id": %d, %s, "vulnerability_ids": [{"vulnerability_id": "CVE-2023-1234"}], "related_fields": {"test": {"id": 1, "engagement": {"id": 1, "product": {"id": 3, "name": "A Product", "prod_type": {"id": 2, "name": "A Product Type"}}}}}}`, id, flags)
+}
+
+func TestFindingBulkTriageResourceApply(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+	server := findingBulkTriageServer(t, []string{
+		bulkTriageFinding(1, `"active": true, "verified": true, "out_of_scope": false`),
+		// already triaged, so it's left alone
+		bulkTriageFinding(2, `"active": false, "verified": true, "out_of_scope": true`),
+	}, patched)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingBulkTriageDefectdojoResource{
+		findingTriageFlags: findingTriageFlags{
+			Active:     ref.Of(false),
+			OutOfScope: ref.Of(true),
+		},
+		VulnerabilityId: ref.Of("CVE-2023-1234"),
+		ProductTypeId:   ref.Of(2),
+		TouchedFindings: types.SetNull(types.ObjectType{AttrTypes: findingBulkTriageTouchedFindingAttrTypes}),
+	}
+	statusCode, _, err := ddTriage.createApiCall(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+
+	assert.DeepEqual(t, patched, map[string]map[string]interface{}{
+		"/api/v2/findings/1/": {"active": false, "out_of_scope": true},
+	})
+	touched := ddTriage.touchedFindings()
+	assert.Equal(t, len(touched), 1)
+	assert.Equal(t, *touched[1].Active, true)
+	assert.Equal(t, *touched[1].OutOfScope, false)
+	// the flags that aren't set are left as they are, so they aren't recorded
+	assert.Assert(t, touched[1].Verified == nil)
+
+	// a flag set later is recorded the first time it is set, and the others keep their first value
+	ddTriage.Verified = ref.Of(false)
+	_, _, err = ddTriage.updateApiCall(context.Background(), client, 42)
+	assert.NilError(t, err)
+	touched = ddTriage.touchedFindings()
+	assert.Equal(t, len(touched), 2)
+	assert.Equal(t, *touched[1].Active, true)
+	assert.Equal(t, *touched[1].Verified, true)
+	assert.Equal(t, *touched[2].Active, false)
+	assert.Equal(t, *touched[2].Verified, true)
+}
+
+func TestFindingBulkTriageResourceIgnoredFilter(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+	server := findingBulkTriageServer(t, []string{
+		bulkTriageFinding(1, `"active": true`),
+		// a server that doesn't know about the vulnerability id filter lists other Findings too
+		`{"id": 2, "active": true, "vulnerability_ids": [{"vulnerability_id": "CVE-2020-0001"}], "related_fields": {"test": {"id": 1, "engagement": {"id": 1, "product": {"id": 3, "name": "A Product", "prod_type": {"id": 2, "name": "A Product Type"}}}}}}`,
+	}, patched)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingBulkTriageDefectdojoResource{
+		findingTriageFlags: findingTriageFlags{
+			Active: ref.Of(false),
+		},
+		VulnerabilityId: ref.Of("CVE-2023-1234"),
+		ProductTypeId:   ref.Of(2),
+		TouchedFindings: types.SetNull(types.ObjectType{AttrTypes: findingBulkTriageTouchedFindingAttrTypes}),
+	}
+	_, _, err = ddTriage.createApiCall(context.Background(), client)
+	assert.ErrorContains(t, err, "The Finding 2 doesn't match the vulnerability_id filter")
+	assert.Equal(t, len(patched), 0)
+
+	// the same goes for the product type filter
+	ddTriage.VulnerabilityId = nil
+	ddTriage.ProductTypeId = ref.Of(4)
+	var finding findingDefectdojoResource
+	assert.NilError(t, json.Unmarshal([]byte(bulkTriageFinding(1, `"active": true`)), &finding.Finding))
+	assert.ErrorContains(t, ddTriage.checkFilters(finding), "The Finding 1 doesn't match the product_type_id filter")
+}
+
+func TestFindingBulkTriageResourceReadReportsUntriaged(t *testing.T) {
+	server := findingBulkTriageServer(t, []string{
+		bulkTriageFinding(1, `"active": false, "out_of_scope": true`),
+		// a finding that started matching since the last apply
+		bulkTriageFinding(3, `"active": false, "out_of_scope": false`),
+	}, nil)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingBulkTriageDefectdojoResource{
+		findingTriageFlags: findingTriageFlags{
+			Active:     ref.Of(false),
+			OutOfScope: ref.Of(true),
+		},
+		VulnerabilityId: ref.Of("CVE-2023-1234"),
+		ProductTypeId:   ref.Of(2),
+	}
+	statusCode, _, err := ddTriage.readApiCall(context.Background(), client, 42)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 200)
+	assert.Equal(t, ddTriage.Id, 42)
+	assert.Equal(t, *ddTriage.Active, false)
+	assert.Equal(t, *ddTriage.OutOfScope, false)
+	assert.Assert(t, ddTriage.Verified == nil)
+}
+
+func TestFindingBulkTriageResourceDeleteRestoresTouched(t *testing.T) {
+	patched := map[string]map[string]interface{}{}
+	server := findingBulkTriageServer(t, nil, patched)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingBulkTriageDefectdojoResource{}
+	ddTriage.setTouchedFindings(map[int]findingTriageFlags{
+		1: {Active: ref.Of(true)},
+		2: {Active: ref.Of(true), Verified: ref.Of(true)},
+		3: {Verified: ref.Of(true)},
+	})
+	// verified isn't set anymore, so it isn't restored
+	data := findingBulkTriageResourceData{
+		Active:          types.BoolValue(false),
+		Verified:        types.BoolNull(),
+		FalseP:          types.BoolNull(),
+		OutOfScope:      types.BoolNull(),
+		UnderReview:     types.BoolNull(),
+		IsMitigated:     types.BoolNull(),
+		TouchedFindings: ddTriage.TouchedFindings,
+	}
+	statusCode, _, err := data.defectdojoResource().deleteApiCall(context.Background(), client, 42)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 204)
+
+	assert.DeepEqual(t, patched, map[string]map[string]interface{}{
+		"/api/v2/findings/1/": {"active": true},
+		"/api/v2/findings/2/": {"active": true},
+	})
+}
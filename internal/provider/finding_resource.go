@@ -0,0 +1,481 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// findingSeverities maps the severities of a Finding to the numerical severity DefectDojo sorts them by.
+var findingSeverities = map[string]string{
+	"Critical": "S0",
+	"High":     "S1",
+	"Medium":   "S2",
+	"Low":      "S3",
+	"Info":     "S4",
+}
+
+func (t findingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Finding authored by hand, for example the result of a penetration test or an audit, as opposed to one created by importing a scan. Every attribute is refreshed from DefectDojo, so changes made in the UI show up as drift on the attribute that was changed.",
+
+		Attributes: map[string]schema.Attribute{
+			"test_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Test the Finding belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the Finding",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(511),
+				},
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "The severity of the Finding. One of `Critical`, `High`, `Medium`, `Low` or `Info`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Critical", "High", "Medium", "Low", "Info"),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Finding",
+				Required:            true,
+			},
+			"mitigation": schema.StringAttribute{
+				MarkdownDescription: "How the Finding can be mitigated",
+				Optional:            true,
+			},
+			"impact": schema.StringAttribute{
+				MarkdownDescription: "The impact of the Finding",
+				Optional:            true,
+			},
+			"references": schema.StringAttribute{
+				MarkdownDescription: "References about the Finding, such as advisories or articles",
+				Optional:            true,
+			},
+			"cwe": schema.Int64Attribute{
+				MarkdownDescription: "The CWE number of the weakness behind the Finding",
+				Optional:            true,
+			},
+			"vulnerability_ids": schema.SetAttribute{
+				MarkdownDescription: "The vulnerability ids of the Finding, such as CVE or GHSA ids",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"cvssv3": schema.StringAttribute{
+				MarkdownDescription: "The CVSS v3 vector of the Finding, for example `CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\ACVSS:3\.[01]/`), "The vector must start with `CVSS:3.0/` or `CVSS:3.1/`"),
+				},
+			},
+			"cvssv3_score": schema.Float64Attribute{
+				MarkdownDescription: "The CVSS v3 score of the Finding. DefectDojo computes it from `cvssv3` when it is not set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"component_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the affected component",
+				Optional:            true,
+			},
+			"component_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the affected component",
+				Optional:            true,
+			},
+			"file_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the affected file",
+				Optional:            true,
+			},
+			"line": schema.Int64Attribute{
+				MarkdownDescription: "The line of the affected file",
+				Optional:            true,
+			},
+			"endpoint_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the Endpoints affected by the Finding",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Finding is active. Defaults to `true` when the Finding is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Finding has been verified. Defaults to `false` when the Finding is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"false_p": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Finding is a false positive. Defaults to `false` when the Finding is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duplicate": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Finding is a duplicate. Defaults to `false` when the Finding is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"out_of_scope": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Finding is out of scope. Defaults to `false` when the Finding is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"risk_accepted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the risk of the Finding has been accepted. Defaults to `false` when the Finding is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply to the Finding",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[^\s,]+\z`), "Tags can't contain spaces or commas"),
+					),
+				},
+			},
+			"hash_code": schema.StringAttribute{
+				MarkdownDescription: "The hash code DefectDojo deduplicates the Finding with, which a `defectdojo_finding_triage` can look the Finding up by",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type findingResourceData struct {
+	TestId           types.Int64   `tfsdk:"test_id" ddField:"Test"`
+	Title            types.String  `tfsdk:"title" ddField:"Title"`
+	Severity         types.String  `tfsdk:"severity" ddField:"Severity"`
+	Description      types.String  `tfsdk:"description" ddField:"Description"`
+	Mitigation       types.String  `tfsdk:"mitigation" ddField:"Mitigation"`
+	Impact           types.String  `tfsdk:"impact" ddField:"Impact"`
+	References       types.String  `tfsdk:"references" ddField:"References"`
+	Cwe              types.Int64   `tfsdk:"cwe" ddField:"Cwe"`
+	VulnerabilityIds types.Set     `tfsdk:"vulnerability_ids" ddField:"VulnerabilityIds"`
+	Cvssv3           types.String  `tfsdk:"cvssv3" ddField:"Cvssv3"`
+	Cvssv3Score      types.Float64 `tfsdk:"cvssv3_score" ddField:"Cvssv3Score"`
+	ComponentName    types.String  `tfsdk:"component_name" ddField:"ComponentName"`
+	ComponentVersion types.String  `tfsdk:"component_version" ddField:"ComponentVersion"`
+	FilePath         types.String  `tfsdk:"file_path" ddField:"FilePath"`
+	Line             types.Int64   `tfsdk:"line" ddField:"Line"`
+	EndpointIds      types.Set     `tfsdk:"endpoint_ids" ddField:"Endpoints"`
+	Active           types.Bool    `tfsdk:"active" ddField:"Active"`
+	Verified         types.Bool    `tfsdk:"verified" ddField:"Verified"`
+	FalseP           types.Bool    `tfsdk:"false_p" ddField:"FalseP"`
+	Duplicate        types.Bool    `tfsdk:"duplicate" ddField:"Duplicate"`
+	OutOfScope       types.Bool    `tfsdk:"out_of_scope" ddField:"OutOfScope"`
+	RiskAccepted     types.Bool    `tfsdk:"risk_accepted" ddField:"RiskAccepted"`
+	Tags             types.Set     `tfsdk:"tags" ddField:"Tags"`
+	HashCode         types.String  `tfsdk:"hash_code" ddField:"HashCode"`
+	Id               types.String  `tfsdk:"id" ddField:"Id"`
+}
+
+type findingDefectdojoResource struct {
+	dd.Finding
+	// the client predates vulnerability ids, so we send and parse them ourselves
+	VulnerabilityIds *[]string
+	// the client parses the score as a float32, which doesn't round trip to the float64 of the schema
+	Cvssv3Score *float64
+}
+
+type findingVulnerabilityId struct {
+	VulnerabilityId string `json:"vulnerability_id"`
+}
+
+// findingBody is the request body for creating and updating a Finding. dd.Finding holds
+// a lot of read-only fields whose zero values we don't want to send.
+type findingBody struct {
+	Test              int                      `json:"test"`
+	Title             string                   `json:"title"`
+	Severity          string                   `json:"severity"`
+	NumericalSeverity string                   `json:"numerical_severity"`
+	Description       string                   `json:"description"`
+	Mitigation        *string                  `json:"mitigation"`
+	Impact            *string                  `json:"impact"`
+	References        *string                  `json:"references"`
+	Cwe               *int                     `json:"cwe"`
+	VulnerabilityIds  []findingVulnerabilityId `json:"vulnerability_ids"`
+	Cvssv3            *string                  `json:"cvssv3"`
+	Cvssv3Score       *float64                 `json:"cvssv3_score,omitempty"`
+	ComponentName     *string                  `json:"component_name"`
+	ComponentVersion  *string                  `json:"component_version"`
+	FilePath          *string                  `json:"file_path"`
+	Line              *int                     `json:"line"`
+	Endpoints         []int                    `json:"endpoints"`
+	Active            *bool                    `json:"active,omitempty"`
+	Verified          *bool                    `json:"verified,omitempty"`
+	FalseP            *bool                    `json:"false_p,omitempty"`
+	Duplicate         *bool                    `json:"duplicate,omitempty"`
+	OutOfScope        *bool                    `json:"out_of_scope,omitempty"`
+	RiskAccepted      *bool                    `json:"risk_accepted,omitempty"`
+	Tags              []string                 `json:"tags"`
+}
+
+func (ddr *findingDefectdojoResource) requestBody() (*bytes.Reader, error) {
+	finding := findingBody{
+		Test:              ddr.Test,
+		Title:             ddr.Title,
+		Severity:          ddr.Severity,
+		NumericalSeverity: findingSeverities[ddr.Severity],
+		Description:       ddr.Description,
+		Mitigation:        ddr.Mitigation,
+		Impact:            ddr.Impact,
+		References:        ddr.References,
+		Cwe:               ddr.Cwe,
+		VulnerabilityIds:  []findingVulnerabilityId{},
+		Cvssv3:            ddr.Cvssv3,
+		Cvssv3Score:       ddr.Cvssv3Score,
+		ComponentName:     ddr.ComponentName,
+		ComponentVersion:  ddr.ComponentVersion,
+		FilePath:          ddr.FilePath,
+		Line:              ddr.Line,
+		Endpoints:         []int{},
+		Active:            ddr.Active,
+		Verified:          ddr.Verified,
+		FalseP:            ddr.FalseP,
+		Duplicate:         ddr.Duplicate,
+		OutOfScope:        ddr.OutOfScope,
+		RiskAccepted:      ddr.RiskAccepted,
+		Tags:              []string{},
+	}
+	if ddr.VulnerabilityIds != nil {
+		for _, vulnerabilityId := range *ddr.VulnerabilityIds {
+			finding.VulnerabilityIds = append(finding.VulnerabilityIds, findingVulnerabilityId{VulnerabilityId: vulnerabilityId})
+		}
+	}
+	if ddr.Endpoints != nil {
+		finding.Endpoints = *ddr.Endpoints
+	}
+	if ddr.Tags != nil {
+		finding.Tags = *ddr.Tags
+	}
+
+	body, err := json.Marshal(finding)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (ddr *findingDefectdojoResource) setFromResponse(finding dd.Finding, body []byte) error {
+	var extra struct {
+		VulnerabilityIds *[]findingVulnerabilityId `json:"vulnerability_ids"`
+		Cvssv3Score      *float64                  `json:"cvssv3_score"`
+	}
+	if err := json.Unmarshal(body, &extra); err != nil {
+		return err
+	}
+	ddr.Finding = finding
+	ddr.VulnerabilityIds = nil
+	if extra.VulnerabilityIds != nil {
+		vulnerabilityIds := []string{}
+		for _, vulnerabilityId := range *extra.VulnerabilityIds {
+			vulnerabilityIds = append(vulnerabilityIds, vulnerabilityId.VulnerabilityId)
+		}
+		ddr.VulnerabilityIds = &vulnerabilityIds
+	}
+	ddr.Cvssv3Score = extra.Cvssv3Score
+	return nil
+}
+
+const findingsPath = "api/v2/findings/"
+
+type findingList struct {
+	Next    *string           `json:"next"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// listFindings pages through all the Findings matching the filters, which are given as the query
+// parameters of the findings list endpoint. The client doesn't know about some of the filters, such
+// as the vulnerability id, so the findings are listed directly.
+func listFindings(ctx context.Context, client *dd.ClientWithResponses, filters url.Values) ([]findingDefectdojoResource, error) {
+	findings := []findingDefectdojoResource{}
+	for offset := 0; ; offset += listPageSize {
+		var page findingList
+		query := url.Values{}
+		for name, values := range filters {
+			query[name] = values
+		}
+		query.Set("limit", strconv.Itoa(listPageSize))
+		query.Set("offset", strconv.Itoa(offset))
+
+		statusCode, body, err := rawApiCall(ctx, client, http.MethodGet, findingsPath, query, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		if statusCode != 200 {
+			return nil, fmt.Errorf("Unexpected response code from the findings API: %d\n\nbody:\n\n%+v", statusCode, string(body))
+		}
+
+		for _, result := range page.Results {
+			var finding dd.Finding
+			if err := json.Unmarshal(result, &finding); err != nil {
+				return nil, err
+			}
+			ddFinding := findingDefectdojoResource{}
+			if err := ddFinding.setFromResponse(finding, result); err != nil {
+				return nil, err
+			}
+			findings = append(findings, ddFinding)
+		}
+
+		if page.Next == nil {
+			break
+		}
+	}
+	return findings, nil
+}
+
+func (ddr *findingDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.FindingsCreateWithBodyWithResponse(ctx, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.StatusCode() == 201 {
+		// the create response is a dd.FindingCreate, so we read the Finding back to get all of its fields
+		var created struct {
+			Id int `json:"id"`
+		}
+		if err := json.Unmarshal(apiResp.Body, &created); err != nil {
+			return 0, nil, err
+		}
+		statusCode, body, err := ddr.readApiCall(ctx, client, created.Id)
+		if err != nil {
+			return 0, nil, err
+		}
+		if statusCode != 200 {
+			return statusCode, body, nil
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *findingDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.FindingsRetrieveWithResponse(ctx, idNumber, &dd.FindingsRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *findingDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.FindingsUpdateWithBodyWithResponse(ctx, idNumber, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *findingDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.FindingsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type findingResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &findingResource{}
+var _ resource.ResourceWithImportState = &findingResource{}
+
+func NewFindingResource() resource.Resource {
+	return &findingResource{
+		terraformResource: terraformResource{
+			dataProvider: findingDataProvider{},
+		},
+	}
+}
+
+func (r findingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_finding"
+}
+
+type findingDataProvider struct{}
+
+func (r findingDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data findingResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *findingResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *findingResourceData) defectdojoResource() defectdojoResource {
+	return &findingDefectdojoResource{
+		Finding: dd.Finding{},
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFindingResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-finding-%s", resource.UniqueId())
+	updatedTitle := fmt.Sprintf("dox-new-finding-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFindingResourceConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "title", title),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "severity", "High"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "description", "An injection in the login form"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "mitigation", "Use prepared statements"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "impact", "The database can be read"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "references", "https://owasp.org/www-community/attacks/SQL_Injection"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "cwe", "89"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "vulnerability_ids.#", "1"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "vulnerability_ids.0", "CVE-2023-1234"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "cvssv3", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "cvssv3_score", "7.5"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "component_name", "login"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "component_version", "1.2.3"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "file_path", "app/login.py"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "line", "42"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "verified", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "false_p", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "duplicate", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "out_of_scope", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "risk_accepted", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "tags.0", "bar"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "tags.1", "foo"),
+					resource.TestCheckResourceAttrSet("defectdojo_finding.test", "hash_code"),
+					resource.TestCheckResourceAttrPair("defectdojo_finding.test", "test_id", "defectdojo_test.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_finding.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFindingResourceMinimalConfig(productName, updatedTitle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "title", updatedTitle),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "severity", "Low"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "active", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "out_of_scope", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "vulnerability_ids.#", "0"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "mitigation"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "impact"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "references"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "cvssv3"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "component_name"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "file_path"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding.test", "line"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFindingResourceDeleteDrift(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFindingResourceMinimalConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "title", title),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccFindingResourceMinimalConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_finding.test"),
+				),
+			},
+			{
+				Config: testAccFindingResourceMinimalConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "title", title),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFindingResourceTestConfig(productName string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+}
+resource "defectdojo_test" "test" {
+  engagement_id = defectdojo_engagement.test.id
+  test_type_id = 1
+  target_start = "2023-01-01T00:00:00Z"
+  target_end = "2023-01-31T00:00:00Z"
+}
+`, productName)
+}
+
+func testAccFindingResourceConfig(productName string, title string) string {
+	return testAccFindingResourceTestConfig(productName) + fmt.Sprintf(`
+resource "defectdojo_finding" "test" {
+  test_id = defectdojo_test.test.id
+  title = %[1]q
+  severity = "High"
+  description = "An injection in the login form"
+  mitigation = "Use prepared statements"
+  impact = "The database can be read"
+  references = "https://owasp.org/www-community/attacks/SQL_Injection"
+  cwe = 89
+  vulnerability_ids = ["CVE-2023-1234"]
+  cvssv3 = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"
+  component_name = "login"
+  component_version = "1.2.3"
+  file_path = "app/login.py"
+  line = 42
+  verified = true
+  tags = ["foo", "bar"]
+}
+`, title)
+}
+
+func testAccFindingResourceMinimalConfig(productName string, title string) string {
+	return testAccFindingResourceTestConfig(productName) + fmt.Sprintf(`
+resource "defectdojo_finding" "test" {
+  test_id = defectdojo_test.test.id
+  title = %[1]q
+  severity = "Low"
+  description = "An injection in the login form"
+  active = false
+  out_of_scope = true
+}
+`, title)
+}
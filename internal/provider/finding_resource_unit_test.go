@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestFindingResource__requestBody(t *testing.T) {
+	ddFinding := findingDefectdojoResource{
+		Finding: dd.Finding{
+			Test:        3,
+			Title:       "A Finding",
+			Severity:    "High",
+			Description: "A description",
+			Active:      ref.Of(false),
+		},
+		VulnerabilityIds: &[]string{"CVE-2023-1234", "GHSA-abcd-efgh-ijkl"},
+		Cvssv3Score:      ref.Of(7.5),
+	}
+
+	reader, err := ddFinding.requestBody()
+	assert.NilError(t, err)
+	body, err := io.ReadAll(reader)
+	assert.NilError(t, err)
+
+	var sent map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	assert.Equal(t, sent["test"], float64(3))
+	assert.Equal(t, sent["numerical_severity"], "S1")
+	assert.Equal(t, sent["active"], false)
+	assert.Equal(t, sent["cvssv3_score"], 7.5)
+	assert.DeepEqual(t, sent["vulnerability_ids"], []interface{}{
+		map[string]interface{}{"vulnerability_id": "CVE-2023-1234"},
+		map[string]interface{}{"vulnerability_id": "GHSA-abcd-efgh-ijkl"},
+	})
+	// unset lists are sent empty so that an update clears them
+	assert.DeepEqual(t, sent["tags"], []interface{}{})
+	assert.DeepEqual(t, sent["endpoints"], []interface{}{})
+	// unset flags are left to DefectDojo
+	_, ok := sent["verified"]
+	assert.Equal(t, ok, false)
+	// unset values are sent as null so that an update clears them
+	value, ok := sent["mitigation"]
+	assert.Equal(t, ok, true)
+	assert.Assert(t, value == nil)
+}
+
+func TestFindingResource__requestBodyUnknownFlags(t *testing.T) {
+	// unconfigured flags are unknown when the Finding is created
+	findingResource := findingResourceData{
+		TestId:           types.Int64Value(3),
+		Title:            types.StringValue("A Finding"),
+		Severity:         types.StringValue("High"),
+		Description:      types.StringValue("A description"),
+		VulnerabilityIds: types.SetNull(types.StringType),
+		Cvssv3Score:      types.Float64Null(),
+		EndpointIds:      types.SetNull(types.Int64Type),
+		Active:           types.BoolUnknown(),
+		Verified:         types.BoolUnknown(),
+		FalseP:           types.BoolUnknown(),
+		Duplicate:        types.BoolUnknown(),
+		OutOfScope:       types.BoolUnknown(),
+		RiskAccepted:     types.BoolUnknown(),
+		Tags:             types.SetNull(types.StringType),
+	}
+	var terraformResource terraformResourceData = &findingResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	reader, err := ddResource.(*findingDefectdojoResource).requestBody()
+	assert.NilError(t, err)
+	body, err := io.ReadAll(reader)
+	assert.NilError(t, err)
+
+	var sent map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	// they are left out so that DefectDojo's defaults apply, such as an active Finding
+	for _, flag := range []string{"active", "verified", "false_p", "duplicate", "out_of_scope", "risk_accepted"} {
+		_, ok := sent[flag]
+		assert.Equal(t, ok, false, flag)
+	}
+}
+
+func TestFindingResource__setFromResponse(t *testing.T) {
+	body := []byte(`{"id": 5, "test": 3, "title": "A Finding", "severity": "High", "description": "A description", "verified": true, "cvssv3_score": 9.8, "vulnerability_ids": [{"vulnerability_id": "CVE-2023-1234"}]}`)
+	var finding dd.Finding
+	assert.NilError(t, json.Unmarshal(body, &finding))
+
+	ddFinding := findingDefectdojoResource{}
+	assert.NilError(t, ddFinding.setFromResponse(finding, body))
+	assert.Equal(t, ddFinding.Id, 5)
+	assert.Equal(t, *ddFinding.Verified, true)
+	// the score is parsed as a float64 so that it isn't rounded
+	assert.Equal(t, *ddFinding.Cvssv3Score, 9.8)
+	assert.DeepEqual(t, *ddFinding.VulnerabilityIds, []string{"CVE-2023-1234"})
+
+	// older versions of DefectDojo don't know about vulnerability ids
+	assert.NilError(t, ddFinding.setFromResponse(finding, []byte(`{"id": 5, "title": "A Finding"}`)))
+	assert.Assert(t, ddFinding.VulnerabilityIds == nil)
+	assert.Assert(t, ddFinding.Cvssv3Score == nil)
+}
+
+func TestFindingResource__populateCvssv3Score(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	data := findingResourceData{}
+	var terraformResource terraformResourceData = &data
+	populateResourceData(ctx, &diags, &terraformResource, &findingDefectdojoResource{
+		Cvssv3Score: ref.Of(9.8),
+	})
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, data.Cvssv3Score.ValueFloat64(), 9.8)
+
+	var ddResource defectdojoResource = &findingDefectdojoResource{}
+	populateDefectdojoResource(ctx, &diags, &data, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, *ddResource.(*findingDefectdojoResource).Cvssv3Score, 9.8)
+
+	// an unknown score is left for DefectDojo to compute
+	data.Cvssv3Score = types.Float64Unknown()
+	ddResource = &findingDefectdojoResource{}
+	populateDefectdojoResource(ctx, &diags, &data, &ddResource)
+	assert.Assert(t, ddResource.(*findingDefectdojoResource).Cvssv3Score == nil)
+}
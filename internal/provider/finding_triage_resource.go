@@ -0,0 +1,470 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t findingTriageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	flagAttribute := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description + " Left as it is when not set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	previousFlagAttributes := map[string]schema.Attribute{}
+	for name := range findingTriageFlagAttrTypes {
+		previousFlagAttributes[name] = schema.BoolAttribute{
+			Computed: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The triage of an existing DefectDojo Finding, for example one created by importing a scan. Only the flags that are set are managed, and the `justification` is added to the Finding as a note. The Finding is addressed either by its `finding_id`, or by its `hash_code` within the Product `product_id`. Destroying this resource restores the flags that were set when the triage was created to the values the Finding had before, and removes the note, but leaves the Finding in place.\n\nDefectDojo rejects some combinations of flags, for example a false positive can't be verified, so a false positive is usually triaged with `false_p = true`, `active = false` and `verified = false`.",
+
+		Attributes: map[string]schema.Attribute{
+			"finding_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Finding",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("hash_code")),
+				},
+			},
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product the Finding is looked up in by its `hash_code`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("hash_code")),
+				},
+			},
+			"hash_code": schema.StringAttribute{
+				MarkdownDescription: "The hash code of the Finding, which stays the same when the scan is imported again. Duplicates are ignored when looking up the Finding.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("product_id")),
+				},
+			},
+			"active":       flagAttribute("Whether the Finding is active."),
+			"verified":     flagAttribute("Whether the Finding has been verified."),
+			"false_p":      flagAttribute("Whether the Finding is a false positive."),
+			"out_of_scope": flagAttribute("Whether the Finding is out of scope."),
+			"under_review": flagAttribute("Whether the Finding is under review."),
+			"is_mitigated": flagAttribute("Whether the Finding has been mitigated."),
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "The justification of the triage, which is added to the Finding as a note",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"note_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the note holding the justification",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_flags": schema.SingleNestedAttribute{
+				MarkdownDescription: "The flags the Finding had before the triage, which are restored when this resource is destroyed. Only the flags that were set when the triage was created are recorded, the others are null. They are unknown when the triage was imported, in which case the flags are left as they are.",
+				Computed:            true,
+				Attributes:          previousFlagAttributes,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier, which is the ID of the Finding",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type findingTriageResourceData struct {
+	FindingId     types.Int64  `tfsdk:"finding_id" ddField:"FindingId"`
+	ProductId     types.Int64  `tfsdk:"product_id" ddField:"ProductId"`
+	HashCode      types.String `tfsdk:"hash_code" ddField:"HashCode"`
+	Active        types.Bool   `tfsdk:"active" ddField:"Active"`
+	Verified      types.Bool   `tfsdk:"verified" ddField:"Verified"`
+	FalseP        types.Bool   `tfsdk:"false_p" ddField:"FalseP"`
+	OutOfScope    types.Bool   `tfsdk:"out_of_scope" ddField:"OutOfScope"`
+	UnderReview   types.Bool   `tfsdk:"under_review" ddField:"UnderReview"`
+	IsMitigated   types.Bool   `tfsdk:"is_mitigated" ddField:"IsMitigated"`
+	Justification types.String `tfsdk:"justification" ddField:"Justification"`
+	NoteId        types.Int64  `tfsdk:"note_id" ddField:"NoteId"`
+	PreviousFlags types.Object `tfsdk:"previous_flags" ddField:"PreviousFlags"`
+	Id            types.String `tfsdk:"id" ddField:"Id"`
+}
+
+var findingTriageFlagAttrTypes = map[string]attr.Type{
+	"active":       types.BoolType,
+	"verified":     types.BoolType,
+	"false_p":      types.BoolType,
+	"out_of_scope": types.BoolType,
+	"under_review": types.BoolType,
+	"is_mitigated": types.BoolType,
+}
+
+// findingTriageFlags are the flags of a Finding that can be triaged. Unset flags are left out of
+// the request body, so that they are left as they are.
+type findingTriageFlags struct {
+	Active      *bool `json:"active,omitempty"`
+	Verified    *bool `json:"verified,omitempty"`
+	FalseP      *bool `json:"false_p,omitempty"`
+	OutOfScope  *bool `json:"out_of_scope,omitempty"`
+	UnderReview *bool `json:"under_review,omitempty"`
+	IsMitigated *bool `json:"is_mitigated,omitempty"`
+}
+
+func findingTriageFlagsOf(finding dd.Finding) findingTriageFlags {
+	return findingTriageFlags{
+		Active:      finding.Active,
+		Verified:    finding.Verified,
+		FalseP:      finding.FalseP,
+		OutOfScope:  finding.OutOfScope,
+		UnderReview: finding.UnderReview,
+		IsMitigated: finding.IsMitigated,
+	}
+}
+
+// fields returns pointers to each of the flags, in the same order for all flags.
+func (flags *findingTriageFlags) fields() []**bool {
+	return []**bool{&flags.Active, &flags.Verified, &flags.FalseP, &flags.OutOfScope, &flags.UnderReview, &flags.IsMitigated}
+}
+
+// previous returns the values the Finding has for the flags that are set, which are the ones a triage
+// changes and so has to restore. Flags missing from the response are false.
+func (flags findingTriageFlags) previous(finding dd.Finding) findingTriageFlags {
+	previous := findingTriageFlags{}
+	current := findingTriageFlagsOf(finding)
+	currentFields := current.fields()
+	previousFields := previous.fields()
+	for i, flag := range flags.fields() {
+		if *flag != nil {
+			*previousFields[i] = ref.Of(*currentFields[i] != nil && **currentFields[i])
+		}
+	}
+	return previous
+}
+
+// object converts the flags to the value of previous_flags, where unset flags are null.
+func (flags findingTriageFlags) object() types.Object {
+	value := func(flag *bool) types.Bool {
+		if flag == nil {
+			return types.BoolNull()
+		}
+		return types.BoolValue(*flag)
+	}
+	return types.ObjectValueMust(findingTriageFlagAttrTypes, map[string]attr.Value{
+		"active":       value(flags.Active),
+		"verified":     value(flags.Verified),
+		"false_p":      value(flags.FalseP),
+		"out_of_scope": value(flags.OutOfScope),
+		"under_review": value(flags.UnderReview),
+		"is_mitigated": value(flags.IsMitigated),
+	})
+}
+
+func findingTriageFlagsFromObject(object types.Object) findingTriageFlags {
+	attributes := object.Attributes()
+	flag := func(name string) *bool {
+		return boolPointer(attributes[name].(types.Bool))
+	}
+	return findingTriageFlags{
+		Active:      flag("active"),
+		Verified:    flag("verified"),
+		FalseP:      flag("false_p"),
+		OutOfScope:  flag("out_of_scope"),
+		UnderReview: flag("under_review"),
+		IsMitigated: flag("is_mitigated"),
+	}
+}
+
+type findingTriageDefectdojoResource struct {
+	findingTriageFlags
+	Id            int
+	FindingId     *int
+	ProductId     *int
+	HashCode      *string
+	Justification *string
+	NoteId        *int
+	PreviousFlags types.Object
+}
+
+func (ddr *findingTriageDefectdojoResource) setFromFinding(finding dd.Finding) {
+	ddr.findingTriageFlags = findingTriageFlagsOf(finding)
+	ddr.Id = finding.Id
+	ddr.FindingId = &finding.Id
+	ddr.HashCode = &finding.HashCode
+}
+
+// findFinding returns the ID of the Finding the triage targets, looking it up by its hash code when
+// no ID was given.
+func (ddr *findingTriageDefectdojoResource) findFinding(ctx context.Context, client *dd.ClientWithResponses) (int, error) {
+	if ddr.FindingId != nil {
+		return *ddr.FindingId, nil
+	}
+	if ddr.HashCode == nil || ddr.ProductId == nil {
+		return 0, fmt.Errorf("Either the finding_id, or the hash_code and the product_id are required to find the Finding")
+	}
+
+	notDuplicate := false
+	apiResp, err := client.FindingsListWithResponse(ctx, &dd.FindingsListParams{
+		HashCode:              ddr.HashCode,
+		TestEngagementProduct: &[]int{*ddr.ProductId},
+		Duplicate:             &notDuplicate,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if apiResp.JSON200 == nil || apiResp.JSON200.Results == nil {
+		return 0, fmt.Errorf("Unexpected response code from the findings API: %d\n\nbody:\n\n%+v", apiResp.StatusCode(), string(apiResp.Body))
+	}
+	findings := *apiResp.JSON200.Results
+	if len(findings) != 1 {
+		return 0, fmt.Errorf("%d Findings with the hash code %s were found in the Product %d, expected exactly one", len(findings), *ddr.HashCode, *ddr.ProductId)
+	}
+	return findings[0].Id, nil
+}
+
+// patchFindingFlags sets the given flags of the Finding, leaving the others as they are.
+func patchFindingFlags(ctx context.Context, client *dd.ClientWithResponses, findingId int, flags findingTriageFlags) (*dd.FindingsPartialUpdateResponse, error) {
+	body, err := json.Marshal(flags)
+	if err != nil {
+		return nil, err
+	}
+	return client.FindingsPartialUpdateWithBodyWithResponse(ctx, findingId, "application/json", bytes.NewReader(body))
+}
+
+// syncJustification makes sure the note holding the justification exists and is up to date.
+func (ddr *findingTriageDefectdojoResource) syncJustification(ctx context.Context, client *dd.ClientWithResponses, findingId int) error {
+	if ddr.NoteId != nil {
+		apiResp, err := client.NotesRetrieveWithResponse(ctx, *ddr.NoteId)
+		if err != nil {
+			return err
+		}
+		if apiResp.JSON200 != nil {
+			if apiResp.JSON200.Entry == *ddr.Justification {
+				return nil
+			}
+			// the entry is the only field we send, so the rest of the note is left as it is
+			body, err := json.Marshal(map[string]string{"entry": *ddr.Justification})
+			if err != nil {
+				return err
+			}
+			updateResp, err := client.NotesPartialUpdateWithBodyWithResponse(ctx, *ddr.NoteId, "application/json", bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+			if updateResp.JSON200 == nil {
+				return fmt.Errorf("Unexpected response code from the notes API: %d\n\nbody:\n\n%+v", updateResp.StatusCode(), string(updateResp.Body))
+			}
+			return nil
+		} else if apiResp.StatusCode() != 404 {
+			return fmt.Errorf("Unexpected response code from the notes API: %d\n\nbody:\n\n%+v", apiResp.StatusCode(), string(apiResp.Body))
+		}
+		// the note has been deleted outside of terraform, so we add it again
+	}
+
+	apiResp, err := client.FindingsNotesCreateWithResponse(ctx, findingId, dd.FindingsNotesCreateJSONRequestBody{
+		Entry: *ddr.Justification,
+	})
+	if err != nil {
+		return err
+	}
+	if apiResp.JSON201 == nil {
+		return fmt.Errorf("Unexpected response code from the finding notes API: %d\n\nbody:\n\n%+v", apiResp.StatusCode(), string(apiResp.Body))
+	}
+	ddr.NoteId = &apiResp.JSON201.Id
+	return nil
+}
+
+func (ddr *findingTriageDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	findingId, err := ddr.findFinding(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// the flags the triage changes are restored to the values the Finding has now on destroy
+	readResp, err := client.FindingsRetrieveWithResponse(ctx, findingId, &dd.FindingsRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if readResp.JSON200 == nil {
+		return readResp.StatusCode(), readResp.Body, nil
+	}
+	ddr.PreviousFlags = ddr.findingTriageFlags.previous(*readResp.JSON200).object()
+
+	apiResp, err := patchFindingFlags(ctx, client, findingId, ddr.findingTriageFlags)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 == nil {
+		return apiResp.StatusCode(), apiResp.Body, nil
+	}
+	ddr.setFromFinding(*apiResp.JSON200)
+
+	if err := ddr.syncJustification(ctx, client, findingId); err != nil {
+		return 0, nil, err
+	}
+
+	return 201, apiResp.Body, nil
+}
+
+func (ddr *findingTriageDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.FindingsRetrieveWithResponse(ctx, idNumber, &dd.FindingsRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.setFromFinding(*apiResp.JSON200)
+
+		ddr.Justification = nil
+		if ddr.NoteId != nil {
+			noteResp, err := client.NotesRetrieveWithResponse(ctx, *ddr.NoteId)
+			if err != nil {
+				return 0, nil, err
+			}
+			if noteResp.JSON200 != nil {
+				ddr.Justification = &noteResp.JSON200.Entry
+			} else if noteResp.StatusCode() == 404 {
+				ddr.NoteId = nil
+			} else {
+				return noteResp.StatusCode(), noteResp.Body, nil
+			}
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *findingTriageDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := patchFindingFlags(ctx, client, idNumber, ddr.findingTriageFlags)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.setFromFinding(*apiResp.JSON200)
+		if err := ddr.syncJustification(ctx, client, idNumber); err != nil {
+			return 0, nil, err
+		}
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *findingTriageDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// an imported triage doesn't know the previous flags, so they are left as they are, and the flags
+	// that weren't recorded are null and so left out of the patch
+	if !ddr.PreviousFlags.IsNull() && !ddr.PreviousFlags.IsUnknown() {
+		apiResp, err := patchFindingFlags(ctx, client, idNumber, findingTriageFlagsFromObject(ddr.PreviousFlags))
+		if err != nil {
+			return 0, nil, err
+		}
+		if apiResp.StatusCode() == 404 {
+			// the Finding is gone, and its notes with it
+			return 204, nil, nil
+		}
+		if apiResp.JSON200 == nil {
+			return apiResp.StatusCode(), apiResp.Body, nil
+		}
+	}
+
+	if ddr.NoteId != nil {
+		apiResp, err := client.FindingsRemoveNotePartialUpdateWithResponse(ctx, idNumber, dd.FindingsRemoveNotePartialUpdateJSONRequestBody{
+			NoteId: ddr.NoteId,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		if apiResp.StatusCode() != 204 && apiResp.StatusCode() != 404 {
+			return apiResp.StatusCode(), apiResp.Body, nil
+		}
+	}
+
+	return 204, nil, nil
+}
+
+type findingTriageResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &findingTriageResource{}
+var _ resource.ResourceWithImportState = &findingTriageResource{}
+
+func NewFindingTriageResource() resource.Resource {
+	return &findingTriageResource{
+		terraformResource: terraformResource{
+			dataProvider: findingTriageDataProvider{},
+		},
+	}
+}
+
+func (r findingTriageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_finding_triage"
+}
+
+type findingTriageDataProvider struct{}
+
+func (r findingTriageDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data findingTriageResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *findingTriageResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *findingTriageResourceData) defectdojoResource() defectdojoResource {
+	// the delete is only given what is set here, and restoring the Finding takes the previous flags
+	// and the note
+	ddr := &findingTriageDefectdojoResource{
+		PreviousFlags: d.PreviousFlags,
+	}
+	if !d.NoteId.IsNull() && !d.NoteId.IsUnknown() {
+		noteId := int(d.NoteId.ValueInt64())
+		ddr.NoteId = &noteId
+	}
+	return ddr
+}
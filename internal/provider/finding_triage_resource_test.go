@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFindingTriageResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-finding-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFindingTriageResourceConfig(productName, title, "Only reachable from the VPN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_finding_triage.test", "finding_id", "defectdojo_finding.test", "id"),
+					resource.TestCheckResourceAttrSet("defectdojo_finding_triage.test", "hash_code"),
+					resource.TestCheckResourceAttrSet("defectdojo_finding_triage.test", "note_id"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "justification", "Only reachable from the VPN"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "out_of_scope", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "active", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "verified", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "previous_flags.active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "previous_flags.out_of_scope", "false"),
+					resource.TestCheckNoResourceAttr("defectdojo_finding_triage.test", "previous_flags.verified"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_finding_triage.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the note and the previous flags can't be found from the Finding
+				ImportStateVerifyIgnore: []string{"justification", "note_id", "previous_flags"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFindingTriageResourceConfig(productName, title, "Only reachable from the office VPN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "justification", "Only reachable from the office VPN"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "out_of_scope", "true"),
+				),
+			},
+			// Destroying the triage restores the flags of the Finding
+			{
+				Config: testAccFindingTriageResourceFindingConfig(productName, title),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "out_of_scope", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "active", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFindingTriageResourceByHashCode(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-finding-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingTriageResourceFindingConfig(productName, title),
+			},
+			{
+				Config: testAccFindingTriageResourceByHashCodeConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_finding_triage.test", "finding_id", "defectdojo_finding.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "false_p", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "active", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "verified", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFindingTriageResourceDeleteDrift(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFindingTriageResourceConfig(productName, title, "Only reachable from the VPN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "out_of_scope", "true"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccFindingTriageResourceConfig(productName, title, "Only reachable from the VPN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_finding_triage.test"),
+				),
+			},
+			{
+				Config: testAccFindingTriageResourceConfig(productName, title, "Only reachable from the VPN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding_triage.test", "out_of_scope", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFindingTriageResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "defectdojo" {}
+resource "defectdojo_finding_triage" "test" {
+  hash_code = "abc123"
+  justification = "A hash code needs a product"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccFindingTriageResourceFindingConfig(productName string, title string) string {
+	return testAccFindingResourceTestConfig(productName) + fmt.Sprintf(`
+resource "defectdojo_finding" "test" {
+  test_id = defectdojo_test.test.id
+  title = %[1]q
+  severity = "Low"
+  description = "An injection in the login form"
+}
+`, title)
+}
+
+func testAccFindingTriageResourceConfig(productName string, title string, justification string) string {
+	return testAccFindingTriageResourceFindingConfig(productName, title) + fmt.Sprintf(`
+resource "defectdojo_finding_triage" "test" {
+  finding_id = defectdojo_finding.test.id
+  out_of_scope = true
+  active = false
+  justification = %[1]q
+}
+`, justification)
+}
+
+func testAccFindingTriageResourceByHashCodeConfig(productName string, title string) string {
+	return testAccFindingTriageResourceFindingConfig(productName, title) + `
+resource "defectdojo_finding_triage" "test" {
+  product_id = defectdojo_product.test.id
+  hash_code = defectdojo_finding.test.hash_code
+  false_p = true
+  active = false
+  verified = false
+  justification = "The query is built from constants"
+}
+`
+}
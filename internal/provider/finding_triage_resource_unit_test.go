@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestFindingTriageResource__findFindingByHashCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodGet)
+		assert.Equal(t, r.URL.Path, "/api/v2/findings/")
+		assert.Equal(t, r.URL.Query().Get("hash_code"), "abc123")
+		assert.Equal(t, r.URL.Query().Get("test__engagement__product"), "7")
+		assert.Equal(t, r.URL.Query().Get("duplicate"), "false")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 1, "next": null, "results": [{"id": 42, "hash_code": "abc123"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingTriageDefectdojoResource{
+		ProductId: ref.Of(7),
+		HashCode:  ref.Of("abc123"),
+	}
+	findingId, err := ddTriage.findFinding(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, findingId, 42)
+
+	// an id is used as it is
+	ddTriage.FindingId = ref.Of(3)
+	findingId, err = ddTriage.findFinding(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, findingId, 3)
+}
+
+func TestFindingTriageResource__findFindingNoMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 0, "next": null, "results": []}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingTriageDefectdojoResource{
+		ProductId: ref.Of(7),
+		HashCode:  ref.Of("abc123"),
+	}
+	_, err = ddTriage.findFinding(context.Background(), client)
+	assert.ErrorContains(t, err, "0 Findings with the hash code abc123 were found in the Product 7")
+}
+
+func TestFindingTriageResource__defectdojoResource(t *testing.T) {
+	// a triage is created from its configuration, where the flags that aren't set are null
+	findingTriageResource := findingTriageResourceData{
+		FindingId:     types.Int64Value(42),
+		ProductId:     types.Int64Null(),
+		HashCode:      types.StringNull(),
+		Active:        types.BoolNull(),
+		Verified:      types.BoolNull(),
+		FalseP:        types.BoolValue(true),
+		OutOfScope:    types.BoolNull(),
+		UnderReview:   types.BoolNull(),
+		IsMitigated:   types.BoolNull(),
+		Justification: types.StringValue("Not reachable"),
+		NoteId:        types.Int64Null(),
+		PreviousFlags: types.ObjectNull(findingTriageFlagAttrTypes),
+	}
+	var terraformResource terraformResourceData = &findingTriageResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	// only the configured flags are patched
+	body, err := json.Marshal(ddResource.(*findingTriageDefectdojoResource).findingTriageFlags)
+	assert.NilError(t, err)
+	var patch map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &patch))
+	assert.DeepEqual(t, patch, map[string]interface{}{"false_p": true})
+}
+
+func TestFindingTriageResourceCreateRecordsSetFlags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			w.Write([]byte(`{"id": 42, "active": true, "verified": true}`))
+		case r.Method == http.MethodPatch:
+			w.Write([]byte(`{"id": 42, "active": true, "verified": true, "false_p": true}`))
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 9, "entry": "Not reachable"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTriage := findingTriageDefectdojoResource{
+		findingTriageFlags: findingTriageFlags{FalseP: ref.Of(true)},
+		FindingId:          ref.Of(42),
+		Justification:      ref.Of("Not reachable"),
+	}
+	statusCode, _, err := ddTriage.createApiCall(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+
+	// the flags that aren't set are left as they are on destroy, so they aren't recorded
+	previous := ddTriage.PreviousFlags.Attributes()
+	assert.Equal(t, previous["false_p"], types.BoolValue(false))
+	assert.Assert(t, previous["active"].IsNull())
+	assert.Assert(t, previous["verified"].IsNull())
+}
+
+func TestFindingTriageResourceDeleteRestoresFlags(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NilError(t, err)
+		var patch map[string]interface{}
+		assert.NilError(t, json.Unmarshal(body, &patch))
+
+		switch r.URL.Path {
+		case "/api/v2/findings/42/":
+			// only the flags recorded when the triage was created are restored
+			assert.DeepEqual(t, patch, map[string]interface{}{
+				"active":   true,
+				"verified": false,
+			})
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": 42, "active": true, "verified": false}`))
+		case "/api/v2/findings/42/remove_note/":
+			assert.DeepEqual(t, patch, map[string]interface{}{"note_id": float64(9)})
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	data := findingTriageResourceData{
+		NoteId: types.Int64Value(9),
+		PreviousFlags: findingTriageFlags{
+			Active:   ref.Of(true),
+			Verified: ref.Of(false),
+		}.object(),
+	}
+	statusCode, _, err := data.defectdojoResource().deleteApiCall(context.Background(), client, 42)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 204)
+	assert.DeepEqual(t, requests, []string{
+		"PATCH /api/v2/findings/42/",
+		"PATCH /api/v2/findings/42/remove_note/",
+	})
+}
+
+func TestFindingTriageResourceDeleteImported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	// an imported triage knows neither the previous flags nor the note, so the Finding is left as it is
+	data := findingTriageResourceData{
+		NoteId:        types.Int64Null(),
+		PreviousFlags: types.ObjectNull(findingTriageFlagAttrTypes),
+	}
+	statusCode, _, err := data.defectdojoResource().deleteApiCall(context.Background(), client, 42)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 204)
+}
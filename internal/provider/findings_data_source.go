@@ -0,0 +1,437 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var findingsDataSourceDateRegexp = regexp.MustCompile(`\A\d{4}-\d{2}-\d{2}\z`)
+
+func (t findingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	flagFilter := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for the DefectDojo Findings matching a set of filters, for example to fail a deployment while a Product has active Critical Findings. All the filters are optional, and the Findings have to match all of the ones that are set.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "Only the Findings of the Product with this ID",
+				Optional:            true,
+			},
+			"engagement_id": schema.Int64Attribute{
+				MarkdownDescription: "Only the Findings of the Engagement with this ID",
+				Optional:            true,
+			},
+			"test_id": schema.Int64Attribute{
+				MarkdownDescription: "Only the Findings of the Test with this ID",
+				Optional:            true,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Only the Findings with this severity. One of `Critical`, `High`, `Medium`, `Low` or `Info`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Critical", "High", "Medium", "Low", "Info"),
+				},
+			},
+			"active":        flagFilter("Only the Findings that are, or aren't, active"),
+			"verified":      flagFilter("Only the Findings that are, or aren't, verified"),
+			"duplicate":     flagFilter("Only the Findings that are, or aren't, duplicates"),
+			"false_p":       flagFilter("Only the Findings that are, or aren't, false positives"),
+			"risk_accepted": flagFilter("Only the Findings whose risk is, or isn't, accepted"),
+			"cwe": schema.Int64Attribute{
+				MarkdownDescription: "Only the Findings with this CWE number",
+				Optional:            true,
+			},
+			"vulnerability_id": schema.StringAttribute{
+				MarkdownDescription: "Only the Findings with this vulnerability id, such as a CVE",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only the Findings with any of these tags",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[^\s,]+\z`), "Tags can't contain spaces or commas"),
+					),
+				},
+			},
+			"date_after": schema.StringAttribute{
+				MarkdownDescription: "Only the Findings discovered on or after this date, in the format `YYYY-MM-DD`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(findingsDataSourceDateRegexp, "The date must be in the format YYYY-MM-DD"),
+				},
+			},
+			"date_before": schema.StringAttribute{
+				MarkdownDescription: "Only the Findings discovered on or before this date, in the format `YYYY-MM-DD`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(findingsDataSourceDateRegexp, "The date must be in the format YYYY-MM-DD"),
+				},
+			},
+			"findings": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching Findings",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the Finding",
+							Computed:            true,
+						},
+						"test_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the Test the Finding belongs to",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the Finding",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "The severity of the Finding",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "The date the Finding was discovered",
+							Computed:            true,
+						},
+						"cwe": schema.Int64Attribute{
+							MarkdownDescription: "The CWE number of the Finding",
+							Computed:            true,
+						},
+						"vulnerability_ids": schema.ListAttribute{
+							MarkdownDescription: "The vulnerability ids of the Finding",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"cvssv3_score": schema.Float64Attribute{
+							MarkdownDescription: "The CVSS v3 score of the Finding",
+							Computed:            true,
+						},
+						"component_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the affected component",
+							Computed:            true,
+						},
+						"component_version": schema.StringAttribute{
+							MarkdownDescription: "The version of the affected component",
+							Computed:            true,
+						},
+						"file_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the affected file",
+							Computed:            true,
+						},
+						"line": schema.Int64Attribute{
+							MarkdownDescription: "The line of the affected file",
+							Computed:            true,
+						},
+						"hash_code": schema.StringAttribute{
+							MarkdownDescription: "The hash code DefectDojo deduplicates the Finding with",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Finding is active",
+							Computed:            true,
+						},
+						"verified": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Finding has been verified",
+							Computed:            true,
+						},
+						"duplicate": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Finding is a duplicate",
+							Computed:            true,
+						},
+						"false_p": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Finding is a false positive",
+							Computed:            true,
+						},
+						"out_of_scope": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Finding is out of scope",
+							Computed:            true,
+						},
+						"risk_accepted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the risk of the Finding has been accepted",
+							Computed:            true,
+						},
+						"is_mitigated": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Finding has been mitigated",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The tags of the Finding",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, which is the query the Findings were listed with",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type findingsDataSourceData struct {
+	ProductId       types.Int64                 `tfsdk:"product_id"`
+	EngagementId    types.Int64                 `tfsdk:"engagement_id"`
+	TestId          types.Int64                 `tfsdk:"test_id"`
+	Severity        types.String                `tfsdk:"severity"`
+	Active          types.Bool                  `tfsdk:"active"`
+	Verified        types.Bool                  `tfsdk:"verified"`
+	Duplicate       types.Bool                  `tfsdk:"duplicate"`
+	FalseP          types.Bool                  `tfsdk:"false_p"`
+	RiskAccepted    types.Bool                  `tfsdk:"risk_accepted"`
+	Cwe             types.Int64                 `tfsdk:"cwe"`
+	VulnerabilityId types.String                `tfsdk:"vulnerability_id"`
+	Tags            types.Set                   `tfsdk:"tags"`
+	DateAfter       types.String                `tfsdk:"date_after"`
+	DateBefore      types.String                `tfsdk:"date_before"`
+	Findings        []findingsDataSourceFinding `tfsdk:"findings"`
+	Id              types.String                `tfsdk:"id"`
+}
+
+type findingsDataSourceFinding struct {
+	Id               types.Int64   `tfsdk:"id"`
+	TestId           types.Int64   `tfsdk:"test_id"`
+	Title            types.String  `tfsdk:"title"`
+	Severity         types.String  `tfsdk:"severity"`
+	Date             types.String  `tfsdk:"date"`
+	Cwe              types.Int64   `tfsdk:"cwe"`
+	VulnerabilityIds []string      `tfsdk:"vulnerability_ids"`
+	Cvssv3Score      types.Float64 `tfsdk:"cvssv3_score"`
+	ComponentName    types.String  `tfsdk:"component_name"`
+	ComponentVersion types.String  `tfsdk:"component_version"`
+	FilePath         types.String  `tfsdk:"file_path"`
+	Line             types.Int64   `tfsdk:"line"`
+	HashCode         types.String  `tfsdk:"hash_code"`
+	Active           types.Bool    `tfsdk:"active"`
+	Verified         types.Bool    `tfsdk:"verified"`
+	Duplicate        types.Bool    `tfsdk:"duplicate"`
+	FalseP           types.Bool    `tfsdk:"false_p"`
+	OutOfScope       types.Bool    `tfsdk:"out_of_scope"`
+	RiskAccepted     types.Bool    `tfsdk:"risk_accepted"`
+	IsMitigated      types.Bool    `tfsdk:"is_mitigated"`
+	Tags             []string      `tfsdk:"tags"`
+}
+
+// filters returns the query parameters of the findings list endpoint for the filters that are set.
+// The endpoint doesn't filter on the vulnerability id nor on a range of dates, and ignores unknown
+// parameters, so those filters are applied by matches instead.
+func (d *findingsDataSourceData) filters() url.Values {
+	filters := url.Values{}
+	setInt := func(name string, value types.Int64) {
+		if !value.IsNull() {
+			filters.Set(name, strconv.FormatInt(value.ValueInt64(), 10))
+		}
+	}
+	setString := func(name string, value types.String) {
+		if !value.IsNull() {
+			filters.Set(name, value.ValueString())
+		}
+	}
+	setBool := func(name string, value types.Bool) {
+		if !value.IsNull() {
+			filters.Set(name, strconv.FormatBool(value.ValueBool()))
+		}
+	}
+
+	setInt("test__engagement__product", d.ProductId)
+	setInt("test__engagement", d.EngagementId)
+	setInt("test", d.TestId)
+	setString("severity", d.Severity)
+	setBool("active", d.Active)
+	setBool("verified", d.Verified)
+	setBool("duplicate", d.Duplicate)
+	setBool("false_p", d.FalseP)
+	setBool("risk_accepted", d.RiskAccepted)
+	setInt("cwe", d.Cwe)
+	if !d.Tags.IsNull() {
+		tags := []string{}
+		for _, tag := range d.Tags.Elements() {
+			tags = append(tags, tag.(types.String).ValueString())
+		}
+		filters.Set("tags", strings.Join(tags, ","))
+	}
+	return filters
+}
+
+// matches tells whether the Finding matches the filters that the findings list endpoint doesn't
+// apply.
+func (d *findingsDataSourceData) matches(ddFinding findingDefectdojoResource) bool {
+	if !d.VulnerabilityId.IsNull() {
+		found := false
+		if ddFinding.VulnerabilityIds != nil {
+			for _, vulnerabilityId := range *ddFinding.VulnerabilityIds {
+				found = found || vulnerabilityId == d.VulnerabilityId.ValueString()
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !d.DateAfter.IsNull() || !d.DateBefore.IsNull() {
+		if ddFinding.Date == nil {
+			return false
+		}
+		// dates in the format YYYY-MM-DD sort the same way as strings
+		date := ddFinding.Date.String()
+		if !d.DateAfter.IsNull() && date < d.DateAfter.ValueString() {
+			return false
+		}
+		if !d.DateBefore.IsNull() && date > d.DateBefore.ValueString() {
+			return false
+		}
+	}
+	return true
+}
+
+// list sets the Findings to the ones matching the filters.
+func (d *findingsDataSourceData) list(ctx context.Context, client *dd.ClientWithResponses) error {
+	filters := d.filters()
+	ddFindings, err := listFindings(ctx, client, filters)
+	if err != nil {
+		return err
+	}
+
+	d.Findings = []findingsDataSourceFinding{}
+	for _, ddFinding := range ddFindings {
+		if d.matches(ddFinding) {
+			d.Findings = append(d.Findings, findingsDataSourceFindingOf(ddFinding))
+		}
+	}
+
+	// the filters applied here are part of the query as far as the id goes
+	if !d.VulnerabilityId.IsNull() {
+		filters.Set("vulnerability_id", d.VulnerabilityId.ValueString())
+	}
+	if !d.DateAfter.IsNull() {
+		filters.Set("date_after", d.DateAfter.ValueString())
+	}
+	if !d.DateBefore.IsNull() {
+		filters.Set("date_before", d.DateBefore.ValueString())
+	}
+	d.Id = types.StringValue(filters.Encode())
+	return nil
+}
+
+func findingsDataSourceFindingOf(ddFinding findingDefectdojoResource) findingsDataSourceFinding {
+	flag := func(value *bool) types.Bool {
+		return types.BoolValue(value != nil && *value)
+	}
+	intValue := func(value *int) types.Int64 {
+		if value == nil {
+			return types.Int64Null()
+		}
+		return types.Int64Value(int64(*value))
+	}
+
+	finding := findingsDataSourceFinding{
+		Id:               types.Int64Value(int64(ddFinding.Id)),
+		TestId:           types.Int64Value(int64(ddFinding.Test)),
+		Title:            types.StringValue(ddFinding.Title),
+		Severity:         types.StringValue(ddFinding.Severity),
+		Date:             types.StringNull(),
+		Cwe:              intValue(ddFinding.Cwe),
+		VulnerabilityIds: []string{},
+		Cvssv3Score:      types.Float64Null(),
+		ComponentName:    nonEmptyStringValue(ddFinding.ComponentName),
+		ComponentVersion: nonEmptyStringValue(ddFinding.ComponentVersion),
+		FilePath:         nonEmptyStringValue(ddFinding.FilePath),
+		Line:             intValue(ddFinding.Line),
+		HashCode:         types.StringValue(ddFinding.HashCode),
+		Active:           flag(ddFinding.Active),
+		Verified:         flag(ddFinding.Verified),
+		Duplicate:        flag(ddFinding.Duplicate),
+		FalseP:           flag(ddFinding.FalseP),
+		OutOfScope:       flag(ddFinding.OutOfScope),
+		RiskAccepted:     flag(ddFinding.RiskAccepted),
+		IsMitigated:      flag(ddFinding.IsMitigated),
+		Tags:             []string{},
+	}
+	if ddFinding.Date != nil {
+		finding.Date = types.StringValue(ddFinding.Date.String())
+	}
+	if ddFinding.VulnerabilityIds != nil {
+		finding.VulnerabilityIds = *ddFinding.VulnerabilityIds
+	}
+	if ddFinding.Cvssv3Score != nil {
+		finding.Cvssv3Score = types.Float64Value(*ddFinding.Cvssv3Score)
+	}
+	if ddFinding.Tags != nil {
+		finding.Tags = *ddFinding.Tags
+	}
+	return finding
+}
+
+type findingsDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d findingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_findings"
+}
+
+func NewFindingsDataSource() datasource.DataSource {
+	return &findingsDataSource{}
+}
+
+func (r *findingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d findingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data findingsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.list(ctx, d.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
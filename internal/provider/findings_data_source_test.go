@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFindingsDataSource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-finding-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFindingsDataSourceConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.defectdojo_findings.product", "findings.#", "2"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.#", "1"),
+					resource.TestCheckResourceAttrPair("data.defectdojo_findings.critical", "findings.0.id", "defectdojo_finding.critical", "id"),
+					resource.TestCheckResourceAttrPair("data.defectdojo_findings.critical", "findings.0.test_id", "defectdojo_test.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.title", title+"-critical"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.severity", "Critical"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.cwe", "89"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.vulnerability_ids.0", "CVE-2023-1234"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.component_name", "openssl"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.active", "true"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.critical", "findings.0.tags.0", "base-image"),
+					resource.TestCheckResourceAttrSet("data.defectdojo_findings.critical", "findings.0.hash_code"),
+					resource.TestCheckResourceAttr("data.defectdojo_findings.none", "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig(productName string, title string) string {
+	return testAccFindingResourceTestConfig(productName) + fmt.Sprintf(`
+resource "defectdojo_finding" "critical" {
+  test_id = defectdojo_test.test.id
+  title = "%[1]s-critical"
+  severity = "Critical"
+  description = "A vulnerable base image package"
+  cwe = 89
+  vulnerability_ids = ["CVE-2023-1234"]
+  component_name = "openssl"
+  tags = ["base-image"]
+}
+resource "defectdojo_finding" "low" {
+  test_id = defectdojo_test.test.id
+  title = "%[1]s-low"
+  severity = "Low"
+  description = "A verbose error page"
+}
+data "defectdojo_findings" "product" {
+  product_id = defectdojo_product.test.id
+
+  depends_on = [defectdojo_finding.critical, defectdojo_finding.low]
+}
+data "defectdojo_findings" "critical" {
+  product_id = defectdojo_product.test.id
+  severity = "Critical"
+  active = true
+  vulnerability_id = "CVE-2023-1234"
+
+  depends_on = [defectdojo_finding.critical, defectdojo_finding.low]
+}
+data "defectdojo_findings" "none" {
+  product_id = defectdojo_product.test.id
+  severity = "Critical"
+  false_p = true
+
+  depends_on = [defectdojo_finding.critical, defectdojo_finding.low]
+}
+`, title)
+}
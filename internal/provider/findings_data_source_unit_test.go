@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestFindingsDataSourceFilters(t *testing.T) {
+	data := findingsDataSourceData{
+		ProductId:       types.Int64Value(1),
+		EngagementId:    types.Int64Null(),
+		TestId:          types.Int64Null(),
+		Severity:        types.StringValue("Critical"),
+		Active:          types.BoolValue(true),
+		Verified:        types.BoolNull(),
+		Duplicate:       types.BoolValue(false),
+		FalseP:          types.BoolNull(),
+		RiskAccepted:    types.BoolNull(),
+		Cwe:             types.Int64Null(),
+		VulnerabilityId: types.StringNull(),
+		Tags:            types.SetValueMust(types.StringType, []attr.Value{types.StringValue("foo"), types.StringValue("bar")}),
+		DateAfter:       types.StringValue("2023-01-01"),
+		DateBefore:      types.StringNull(),
+	}
+
+	filters := data.filters()
+	// the date is filtered on after listing, as the endpoint doesn't know about it
+	assert.Equal(t, len(filters), 5)
+	assert.Equal(t, filters.Get("test__engagement__product"), "1")
+	assert.Equal(t, filters.Get("severity"), "Critical")
+	assert.Equal(t, filters.Get("active"), "true")
+	assert.Equal(t, filters.Get("duplicate"), "false")
+	assert.Assert(t, filters.Get("tags") == "foo,bar" || filters.Get("tags") == "bar,foo")
+}
+
+func TestFindingsDataSourceFindingOf(t *testing.T) {
+	var ddFinding findingDefectdojoResource
+	ddFinding.Id = 1
+	ddFinding.Test = 2
+	ddFinding.Title = "SQL injection"
+	ddFinding.Severity = "High"
+	ddFinding.Active = ref.Of(true)
+	ddFinding.ComponentName = ref.Of("")
+	ddFinding.Line = ref.Of(42)
+	ddFinding.VulnerabilityIds = &[]string{"CVE-2023-1234"}
+	ddFinding.Cvssv3Score = ref.Of(7.5)
+
+	finding := findingsDataSourceFindingOf(ddFinding)
+	assert.Equal(t, finding.Id.ValueInt64(), int64(1))
+	assert.Equal(t, finding.TestId.ValueInt64(), int64(2))
+	assert.Equal(t, finding.Active.ValueBool(), true)
+	assert.Equal(t, finding.Verified.ValueBool(), false)
+	assert.Assert(t, finding.ComponentName.IsNull())
+	assert.Assert(t, finding.Cwe.IsNull())
+	assert.Equal(t, finding.Line.ValueInt64(), int64(42))
+	assert.DeepEqual(t, finding.VulnerabilityIds, []string{"CVE-2023-1234"})
+	assert.Equal(t, finding.Cvssv3Score.ValueFloat64(), 7.5)
+	assert.DeepEqual(t, finding.Tags, []string{})
+}
+
+func TestFindingsDataSourceUnsupportedFilters(t *testing.T) {
+	// the server ignores the filters it doesn't know about, and lists every Finding
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Query().Get("vulnerability_id"), "")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 4, "next": null, "results": [
+			{"id": 1, "date": "2023-03-01", "vulnerability_ids": [{"vulnerability_id": "GHSA-xxxx-yyyy-zzzz"}, {"vulnerability_id": "CVE-2023-1234"}]},
+			{"id": 2, "date": "2023-03-01", "vulnerability_ids": [{"vulnerability_id": "CVE-2020-0001"}]},
+			{"id": 3, "date": "2022-12-31", "vulnerability_ids": [{"vulnerability_id": "CVE-2023-1234"}]},
+			{"id": 4, "date": "2023-01-01", "vulnerability_ids": [{"vulnerability_id": "CVE-2023-1234"}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	data := findingsDataSourceData{
+		ProductId:       types.Int64Null(),
+		EngagementId:    types.Int64Null(),
+		TestId:          types.Int64Null(),
+		Severity:        types.StringNull(),
+		Active:          types.BoolNull(),
+		Verified:        types.BoolNull(),
+		Duplicate:       types.BoolNull(),
+		FalseP:          types.BoolNull(),
+		RiskAccepted:    types.BoolNull(),
+		Cwe:             types.Int64Null(),
+		VulnerabilityId: types.StringValue("CVE-2023-1234"),
+		Tags:            types.SetNull(types.StringType),
+		DateAfter:       types.StringValue("2023-01-01"),
+		DateBefore:      types.StringValue("2023-06-30"),
+	}
+	assert.NilError(t, data.list(context.Background(), client))
+
+	ids := []int64{}
+	for _, finding := range data.Findings {
+		ids = append(ids, finding.Id.ValueInt64())
+	}
+	assert.DeepEqual(t, ids, []int64{1, 4})
+	assert.Equal(t, data.Id.ValueString(), "date_after=2023-01-01&date_before=2023-06-30&vulnerability_id=CVE-2023-1234")
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+)
+
+// Global roles are kept in their own endpoint, keyed by either a user or a group. These helpers
+// let the user and group resources manage the global role as if it were one of their attributes.
+
+func findGlobalRole(ctx context.Context, client *dd.ClientWithResponses, params dd.GlobalRolesListParams) (*dd.GlobalRole, error) {
+	apiResp, err := client.GlobalRolesListWithResponse(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.StatusCode() != 200 || apiResp.JSON200 == nil {
+		return nil, fmt.Errorf("Unexpected response code from the global roles API: %d\n\nbody:\n\n%+v", apiResp.StatusCode(), string(apiResp.Body))
+	}
+	if apiResp.JSON200.Results == nil || len(*apiResp.JSON200.Results) == 0 {
+		return nil, nil
+	}
+	return &(*apiResp.JSON200.Results)[0], nil
+}
+
+// readGlobalRole returns the id of the global role matching the params, or nil if there is none.
+func readGlobalRole(ctx context.Context, client *dd.ClientWithResponses, params dd.GlobalRolesListParams) (*int, error) {
+	globalRole, err := findGlobalRole(ctx, client, params)
+	if err != nil || globalRole == nil {
+		return nil, err
+	}
+	return globalRole.Role, nil
+}
+
+// syncGlobalRole creates, updates or removes the global role of the user or group set in want, so
+// that it matches want.Role.
+func syncGlobalRole(ctx context.Context, client *dd.ClientWithResponses, want dd.GlobalRole) error {
+	existing, err := findGlobalRole(ctx, client, dd.GlobalRolesListParams{User: want.User, Group: want.Group})
+	if err != nil {
+		return err
+	}
+
+	var statusCode, expectedStatusCode int
+	var body []byte
+	switch {
+	case existing == nil && want.Role == nil:
+		return nil
+	case existing == nil:
+		apiResp, err := client.GlobalRolesCreateWithResponse(ctx, dd.GlobalRolesCreateJSONRequestBody(want))
+		if err != nil {
+			return err
+		}
+		statusCode, expectedStatusCode, body = apiResp.StatusCode(), 201, apiResp.Body
+	case want.Role == nil:
+		apiResp, err := client.GlobalRolesDestroyWithResponse(ctx, existing.Id)
+		if err != nil {
+			return err
+		}
+		statusCode, expectedStatusCode, body = apiResp.StatusCode(), 204, apiResp.Body
+	case existing.Role == nil || *existing.Role != *want.Role:
+		want.Id = existing.Id
+		apiResp, err := client.GlobalRolesUpdateWithResponse(ctx, existing.Id, dd.GlobalRolesUpdateJSONRequestBody(want))
+		if err != nil {
+			return err
+		}
+		statusCode, expectedStatusCode, body = apiResp.StatusCode(), 200, apiResp.Body
+	default:
+		return nil
+	}
+
+	if statusCode != expectedStatusCode {
+		return fmt.Errorf("Unexpected response code from the global roles API: %d\n\nbody:\n\n%+v", statusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t jiraFindingMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A Jira Finding Mapping links an existing Jira issue to a Finding, or an existing Jira epic to an Engagement, so that DefectDojo updates it instead of creating a new one.",
+
+		Attributes: map[string]schema.Attribute{
+			"finding_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Finding tracked by the Jira issue. Exactly one of `finding_id` or `engagement_id` must be set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("engagement_id")),
+				},
+			},
+			"engagement_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Engagement tracked by the Jira epic. Exactly one of `finding_id` or `engagement_id` must be set.",
+				Optional:            true,
+			},
+			"jira_id": schema.StringAttribute{
+				MarkdownDescription: "The internal id of the Jira issue, as returned by the Jira API",
+				Required:            true,
+			},
+			"jira_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the Jira issue, for example `APPSEC-123`",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Jira issue",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type jiraFindingMappingResourceData struct {
+	FindingId    types.Int64  `tfsdk:"finding_id" ddField:"Finding"`
+	EngagementId types.Int64  `tfsdk:"engagement_id" ddField:"Engagement"`
+	JiraId       types.String `tfsdk:"jira_id" ddField:"JiraId"`
+	JiraKey      types.String `tfsdk:"jira_key" ddField:"JiraKey"`
+	Url          types.String `tfsdk:"url" ddField:"Url"`
+	Id           types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type jiraFindingMappingDefectdojoResource struct {
+	dd.JIRAIssue
+}
+
+func (ddr *jiraFindingMappingDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.JiraFindingMappingsCreateJSONRequestBody(ddr.JIRAIssue)
+	apiResp, err := client.JiraFindingMappingsCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.JIRAIssue = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *jiraFindingMappingDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.JiraFindingMappingsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.JIRAIssue = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *jiraFindingMappingDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.JiraFindingMappingsUpdateJSONRequestBody(ddr.JIRAIssue)
+	apiResp, err := client.JiraFindingMappingsUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.JIRAIssue = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *jiraFindingMappingDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.JiraFindingMappingsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type jiraFindingMappingResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &jiraFindingMappingResource{}
+var _ resource.ResourceWithImportState = &jiraFindingMappingResource{}
+
+func NewJiraFindingMappingResource() resource.Resource {
+	return &jiraFindingMappingResource{
+		terraformResource: terraformResource{
+			dataProvider: jiraFindingMappingDataProvider{},
+		},
+	}
+}
+
+func (r jiraFindingMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_finding_mapping"
+}
+
+type jiraFindingMappingDataProvider struct{}
+
+func (r jiraFindingMappingDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data jiraFindingMappingResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *jiraFindingMappingResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *jiraFindingMappingResourceData) defectdojoResource() defectdojoResource {
+	return &jiraFindingMappingDefectdojoResource{
+		JIRAIssue: dd.JIRAIssue{},
+	}
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraFindingMappingResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-jira-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJiraFindingMappingResourceConfig(name, "10001", "APPSEC-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_jira_finding_mapping.test", "engagement_id", "defectdojo_engagement.test", "id"),
+					resource.TestCheckNoResourceAttr("defectdojo_jira_finding_mapping.test", "finding_id"),
+					resource.TestCheckResourceAttr("defectdojo_jira_finding_mapping.test", "jira_id", "10001"),
+					resource.TestCheckResourceAttr("defectdojo_jira_finding_mapping.test", "jira_key", "APPSEC-1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_jira_finding_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccJiraFindingMappingResourceConfig(name, "10002", "APPSEC-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_finding_mapping.test", "jira_id", "10002"),
+					resource.TestCheckResourceAttr("defectdojo_jira_finding_mapping.test", "jira_key", "APPSEC-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccJiraFindingMappingResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJiraFindingMappingResourceConfig(name, "10001", "APPSEC-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_finding_mapping.test", "jira_key", "APPSEC-1"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccJiraFindingMappingResourceConfig(name, "10001", "APPSEC-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_jira_finding_mapping.test"),
+				),
+			},
+			{
+				Config: testAccJiraFindingMappingResourceConfig(name, "10001", "APPSEC-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_finding_mapping.test", "jira_key", "APPSEC-1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccJiraFindingMappingResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Combination`),
+				Config: `
+provider "defectdojo" {}
+resource "defectdojo_jira_finding_mapping" "test" {
+  jira_id = "10001"
+  jira_key = "APPSEC-1"
+}
+`,
+			},
+		},
+	})
+}
+
+func testAccJiraFindingMappingResourceConfig(name string, jiraId string, jiraKey string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+}
+resource "defectdojo_jira_finding_mapping" "test" {
+  engagement_id = defectdojo_engagement.test.id
+  jira_id = %[2]q
+  jira_key = %[3]q
+}
+`, name, jiraId, jiraKey)
+}
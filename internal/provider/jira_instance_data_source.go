@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t jiraInstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo Jira Instance. You can specify either the `url` or the `configuration_name` to look up the Jira Instance. The `id` can be used as the `jira_instance_id` of a `defectdojo_jira_product_configuration`.",
+
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Jira server",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("configuration_name")),
+				},
+			},
+			"configuration_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira configuration",
+				Optional:            true,
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate to Jira",
+				Computed:            true,
+			},
+			"default_issue_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the issues created in Jira",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type jiraInstanceDataSourceData struct {
+	Url               types.String `tfsdk:"url"`
+	ConfigurationName types.String `tfsdk:"configuration_name"`
+	Username          types.String `tfsdk:"username"`
+	DefaultIssueType  types.String `tfsdk:"default_issue_type"`
+	Id                types.String `tfsdk:"id"`
+}
+
+type jiraInstanceDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d jiraInstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_instance"
+}
+
+func NewJiraInstanceDataSource() datasource.DataSource {
+	return &jiraInstanceDataSource{}
+}
+
+func (r *jiraInstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d jiraInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data jiraInstanceDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var url *string
+	if !data.Url.IsNull() {
+		url = ref.Of(data.Url.ValueString())
+	}
+
+	// the API can't filter on the configuration name, so we go through all
+	// the pages and match it ourselves
+	matches := []dd.JIRAInstance{}
+	for offset := 0; ; offset += listPageSize {
+		apiResp, err := d.client.JiraInstancesListWithResponse(ctx, &dd.JiraInstancesListParams{
+			Url:    url,
+			Limit:  ref.Of(listPageSize),
+			Offset: ref.Of(offset),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Retrieving Resource",
+				fmt.Sprintf("%s", err))
+			return
+		}
+
+		if apiResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error Retrieving Data Source",
+				fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+					fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+			)
+			return
+		}
+
+		if apiResp.JSON200.Results != nil {
+			for _, instance := range *apiResp.JSON200.Results {
+				if data.ConfigurationName.IsNull() || (instance.ConfigurationName != nil && *instance.ConfigurationName == data.ConfigurationName.ValueString()) {
+					matches = append(matches, instance)
+				}
+			}
+		}
+
+		if apiResp.JSON200.Next == nil {
+			break
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			"No Jira Instances matched the given parameters.")
+		return
+	} else if len(matches) > 1 {
+		var urls string
+		for _, instance := range matches {
+			urls += fmt.Sprintf("\n  - %d: %s", instance.Id, instance.Url)
+		}
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			fmt.Sprintf("%d Jira Instances matched the given parameters:\n%s", len(matches), urls))
+		return
+	}
+
+	instance := matches[0]
+	data.Id = types.StringValue(fmt.Sprintf("%d", instance.Id))
+	data.Url = types.StringValue(instance.Url)
+	data.ConfigurationName = nonEmptyStringValue(instance.ConfigurationName)
+	data.Username = types.StringValue(instance.Username)
+	data.DefaultIssueType = nonEmptyStringValue((*string)(instance.DefaultIssueType))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraInstanceUrlDataSource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-jira-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccJiraInstanceDataSourceConfig(name, `url = defectdojo_jira_instance.test.url`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_jira_instance.test", "id", "defectdojo_jira_instance.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_instance.test", "configuration_name", name),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_instance.test", "username", "dojo"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_instance.test", "default_issue_type", "Bug"),
+					resource.TestCheckResourceAttrPair("defectdojo_jira_product_configuration.test", "jira_instance_id", "defectdojo_jira_instance.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraInstanceConfigurationNameDataSource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-jira-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccJiraInstanceDataSourceConfig(name, `configuration_name = defectdojo_jira_instance.test.configuration_name`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_jira_instance.test", "id", "defectdojo_jira_instance.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_instance.test", "url", fmt.Sprintf("https://%s.example.com", name)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraInstanceDataSourceNoMatch(t *testing.T) {
+	name := fmt.Sprintf("dox-test-jira-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Jira Instances matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_jira_instance" "test" {
+  configuration_name = %q
+}
+`, name),
+			},
+		},
+	})
+}
+
+func testAccJiraInstanceDataSourceConfig(name string, lookup string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_jira_instance" "test" {
+  configuration_name = %[1]q
+  url = "https://%[1]s.example.com"
+  username = "dojo"
+  password = "secret"
+  default_issue_type = "Bug"
+  epic_name_id = 10011
+  open_status_key = 11
+  close_status_key = 41
+  info_mapping_severity = "Lowest"
+  low_mapping_severity = "Low"
+  medium_mapping_severity = "Medium"
+  high_mapping_severity = "High"
+  critical_mapping_severity = "Highest"
+}
+data "defectdojo_jira_instance" "test" {
+  %[2]s
+}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_jira_product_configuration" "test" {
+  product_id = defectdojo_product.test.id
+  jira_instance_id = data.defectdojo_jira_instance.test.id
+  project_key = "APPSEC"
+}
+`, name, lookup)
+}
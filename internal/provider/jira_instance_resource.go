@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t jiraInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A Jira Instance holds the connection details DefectDojo uses to push Findings to a Jira server. The password is never read back from the API, so changes made to it outside of Terraform are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"configuration_name": schema.StringAttribute{
+				MarkdownDescription: "The name of this Jira configuration",
+				Optional:            true,
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Jira server",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate to Jira",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password or API token used to authenticate to Jira",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"default_issue_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the issues created in Jira. Valid values are: 'Task', 'Story', 'Epic', 'Spike', 'Bug', 'Security'. Defaults to 'Bug'.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Task", "Story", "Epic", "Spike", "Bug", "Security"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringDefault("Bug"),
+				},
+			},
+			"epic_name_id": schema.Int64Attribute{
+				MarkdownDescription: "The id of the Jira custom field holding the Epic Name. It is the number in `cf[number]` of the Epic Name field listed at https://<YOUR JIRA URL>/rest/api/2/field",
+				Required:            true,
+			},
+			"open_status_key": schema.Int64Attribute{
+				MarkdownDescription: "The id of the transition used to re-open Jira issues",
+				Required:            true,
+			},
+			"close_status_key": schema.Int64Attribute{
+				MarkdownDescription: "The id of the transition used to close Jira issues",
+				Required:            true,
+			},
+			"info_mapping_severity": schema.StringAttribute{
+				MarkdownDescription: "The Jira priority used for Info Findings",
+				Required:            true,
+			},
+			"low_mapping_severity": schema.StringAttribute{
+				MarkdownDescription: "The Jira priority used for Low Findings",
+				Required:            true,
+			},
+			"medium_mapping_severity": schema.StringAttribute{
+				MarkdownDescription: "The Jira priority used for Medium Findings",
+				Required:            true,
+			},
+			"high_mapping_severity": schema.StringAttribute{
+				MarkdownDescription: "The Jira priority used for High Findings",
+				Required:            true,
+			},
+			"critical_mapping_severity": schema.StringAttribute{
+				MarkdownDescription: "The Jira priority used for Critical Findings",
+				Required:            true,
+			},
+			"finding_text": schema.StringAttribute{
+				MarkdownDescription: "Additional text added to every Finding pushed to Jira, for example who to contact for more information",
+				Optional:            true,
+			},
+			"accepted_mapping_resolution": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated Jira resolution names that map to an Accepted Finding",
+				Optional:            true,
+			},
+			"false_positive_mapping_resolution": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated Jira resolution names that map to a False Positive Finding",
+				Optional:            true,
+			},
+			"issue_template_dir": schema.StringAttribute{
+				MarkdownDescription: "The folder containing Django templates used to render the Jira issue description. Leave empty to use the default jira_full templates.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"global_jira_sla_notification": schema.BoolAttribute{
+				MarkdownDescription: "Send SLA notifications as comments. This can be overridden at the Product level. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(true),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type jiraInstanceResourceData struct {
+	ConfigurationName              types.String `tfsdk:"configuration_name" ddField:"ConfigurationName"`
+	Url                            types.String `tfsdk:"url" ddField:"Url"`
+	Username                       types.String `tfsdk:"username" ddField:"Username"`
+	Password                       types.String `tfsdk:"password" ddField:"Password"`
+	DefaultIssueType               types.String `tfsdk:"default_issue_type" ddField:"DefaultIssueType"`
+	EpicNameId                     types.Int64  `tfsdk:"epic_name_id" ddField:"EpicNameId"`
+	OpenStatusKey                  types.Int64  `tfsdk:"open_status_key" ddField:"OpenStatusKey"`
+	CloseStatusKey                 types.Int64  `tfsdk:"close_status_key" ddField:"CloseStatusKey"`
+	InfoMappingSeverity            types.String `tfsdk:"info_mapping_severity" ddField:"InfoMappingSeverity"`
+	LowMappingSeverity             types.String `tfsdk:"low_mapping_severity" ddField:"LowMappingSeverity"`
+	MediumMappingSeverity          types.String `tfsdk:"medium_mapping_severity" ddField:"MediumMappingSeverity"`
+	HighMappingSeverity            types.String `tfsdk:"high_mapping_severity" ddField:"HighMappingSeverity"`
+	CriticalMappingSeverity        types.String `tfsdk:"critical_mapping_severity" ddField:"CriticalMappingSeverity"`
+	FindingText                    types.String `tfsdk:"finding_text" ddField:"FindingText"`
+	AcceptedMappingResolution      types.String `tfsdk:"accepted_mapping_resolution" ddField:"AcceptedMappingResolution"`
+	FalsePositiveMappingResolution types.String `tfsdk:"false_positive_mapping_resolution" ddField:"FalsePositiveMappingResolution"`
+	IssueTemplateDir               types.String `tfsdk:"issue_template_dir" ddField:"IssueTemplateDir"`
+	GlobalJiraSlaNotification      types.Bool   `tfsdk:"global_jira_sla_notification" ddField:"GlobalJiraSlaNotification"`
+	Id                             types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type jiraInstanceDefectdojoResource struct {
+	dd.JIRAInstance
+	// the password is write-only in the API, so it is kept apart from the
+	// instance and only ever comes from the terraform configuration or state
+	Password *string
+}
+
+func (ddr *jiraInstanceDefectdojoResource) requestBody() dd.JIRAInstance {
+	instance := ddr.JIRAInstance
+	if ddr.Password != nil {
+		instance.Password = *ddr.Password
+	}
+	return instance
+}
+
+func (ddr *jiraInstanceDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.JiraInstancesCreateJSONRequestBody(ddr.requestBody())
+	apiResp, err := client.JiraInstancesCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.JIRAInstance = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *jiraInstanceDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.JiraInstancesRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.JIRAInstance = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *jiraInstanceDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.JiraInstancesUpdateJSONRequestBody(ddr.requestBody())
+	apiResp, err := client.JiraInstancesUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.JIRAInstance = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *jiraInstanceDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.JiraInstancesDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type jiraInstanceResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &jiraInstanceResource{}
+var _ resource.ResourceWithImportState = &jiraInstanceResource{}
+
+func NewJiraInstanceResource() resource.Resource {
+	return &jiraInstanceResource{
+		terraformResource: terraformResource{
+			dataProvider: jiraInstanceDataProvider{},
+		},
+	}
+}
+
+func (r jiraInstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_instance"
+}
+
+type jiraInstanceDataProvider struct{}
+
+func (r jiraInstanceDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data jiraInstanceResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *jiraInstanceResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *jiraInstanceResourceData) defectdojoResource() defectdojoResource {
+	return &jiraInstanceDefectdojoResource{
+		JIRAInstance: dd.JIRAInstance{},
+	}
+}
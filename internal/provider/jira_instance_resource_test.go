@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraInstanceResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-jira-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJiraInstanceResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "configuration_name", name),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "url", "https://jira.example.com"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "username", "dojo"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "password", "secret"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "default_issue_type", "Bug"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "epic_name_id", "10011"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "open_status_key", "11"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "close_status_key", "41"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "critical_mapping_severity", "Highest"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "info_mapping_severity", "Lowest"),
+					resource.TestCheckNoResourceAttr("defectdojo_jira_instance.test", "finding_text"),
+					resource.TestCheckNoResourceAttr("defectdojo_jira_instance.test", "accepted_mapping_resolution"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_jira_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the password is never read back from the API
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccJiraInstanceResourceUpdateConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "password", "new-secret"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "default_issue_type", "Security"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "critical_mapping_severity", "Blocker"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "finding_text", "Contact #appsec"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "accepted_mapping_resolution", "Won't Fix"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "false_positive_mapping_resolution", "Not a Bug,Invalid"),
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "global_jira_sla_notification", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccJiraInstanceResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJiraInstanceResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "configuration_name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccJiraInstanceResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_jira_instance.test"),
+				),
+			},
+			{
+				Config: testAccJiraInstanceResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_instance.test", "configuration_name", name),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccJiraInstanceResourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_jira_instance" "test" {
+  configuration_name = %[1]q
+  url = "https://jira.example.com"
+  username = "dojo"
+  password = "secret"
+  default_issue_type = "Bug"
+  epic_name_id = 10011
+  open_status_key = 11
+  close_status_key = 41
+  info_mapping_severity = "Lowest"
+  low_mapping_severity = "Low"
+  medium_mapping_severity = "Medium"
+  high_mapping_severity = "High"
+  critical_mapping_severity = "Highest"
+}
+`, name)
+}
+
+func testAccJiraInstanceResourceUpdateConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_jira_instance" "test" {
+  configuration_name = %[1]q
+  url = "https://jira.example.com"
+  username = "dojo"
+  password = "new-secret"
+  default_issue_type = "Security"
+  epic_name_id = 10011
+  open_status_key = 11
+  close_status_key = 41
+  info_mapping_severity = "Lowest"
+  low_mapping_severity = "Low"
+  medium_mapping_severity = "Medium"
+  high_mapping_severity = "High"
+  critical_mapping_severity = "Blocker"
+  finding_text = "Contact #appsec"
+  accepted_mapping_resolution = "Won't Fix"
+  false_positive_mapping_resolution = "Not a Bug,Invalid"
+  global_jira_sla_notification = true
+}
+`, name)
+}
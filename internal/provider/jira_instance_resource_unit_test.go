@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestJiraInstanceResource__defectdojoResource(t *testing.T) {
+	jiraInstanceResource := jiraInstanceResourceData{
+		Url:                     types.StringValue("https://jira.example.com"),
+		Username:                types.StringValue("dojo"),
+		Password:                types.StringValue("secret"),
+		DefaultIssueType:        types.StringValue("Bug"),
+		EpicNameId:              types.Int64Value(10011),
+		CriticalMappingSeverity: types.StringValue("Highest"),
+	}
+	var terraformResource terraformResourceData = &jiraInstanceResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddJiraInstance := ddResource.(*jiraInstanceDefectdojoResource)
+	assert.Equal(t, ddJiraInstance.Url, "https://jira.example.com")
+	assert.Equal(t, *ddJiraInstance.DefaultIssueType, dd.JIRAInstanceDefaultIssueTypeBug)
+	assert.Equal(t, ddJiraInstance.EpicNameId, 10011)
+	assert.Equal(t, ddJiraInstance.CriticalMappingSeverity, "Highest")
+	assert.Assert(t, ddJiraInstance.FindingText == nil)
+	assert.Equal(t, *ddJiraInstance.Password, "secret")
+	assert.Equal(t, ddJiraInstance.requestBody().Password, "secret")
+}
+
+func TestJiraInstanceResourcePopulate(t *testing.T) {
+	ddJiraInstance := jiraInstanceDefectdojoResource{
+		JIRAInstance: dd.JIRAInstance{
+			Id:                        42,
+			Url:                       "https://jira.example.com",
+			Password:                  "from the api",
+			AcceptedMappingResolution: ref.Of("Won't Fix"),
+			HighMappingSeverity:       "High",
+		},
+	}
+
+	jiraInstanceResource := jiraInstanceResourceData{}
+	var terraformResource terraformResourceData = &jiraInstanceResource
+
+	diags := diag.Diagnostics{}
+	populateResourceData(context.Background(), &diags, &terraformResource, &ddJiraInstance)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, jiraInstanceResource.Id.ValueString(), "42")
+	assert.Equal(t, jiraInstanceResource.Url.ValueString(), "https://jira.example.com")
+	assert.Equal(t, jiraInstanceResource.AcceptedMappingResolution.ValueString(), "Won't Fix")
+	assert.Equal(t, jiraInstanceResource.HighMappingSeverity.ValueString(), "High")
+	assert.Equal(t, jiraInstanceResource.FalsePositiveMappingResolution.IsNull(), true)
+	// the password is never taken from the API response
+	assert.Equal(t, jiraInstanceResource.Password.IsNull(), true)
+}
+
+func TestJiraInstanceResourceDefaults(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	jiraInstanceResource{}.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	// unconfigured computed values are already unknown in the plan when the modifiers run
+	issueType := schemaResp.Schema.Attributes["default_issue_type"].(schema.StringAttribute)
+	issueTypeResp := planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+	for _, modifier := range issueType.PlanModifiers {
+		modifier.PlanModifyString(ctx, planmodifier.StringRequest{ConfigValue: types.StringNull(), PlanValue: types.StringUnknown()}, &issueTypeResp)
+	}
+	assert.Equal(t, issueTypeResp.PlanValue.ValueString(), "Bug")
+
+	slaNotification := schemaResp.Schema.Attributes["global_jira_sla_notification"].(schema.BoolAttribute)
+	slaNotificationResp := planmodifier.BoolResponse{PlanValue: types.BoolUnknown()}
+	for _, modifier := range slaNotification.PlanModifiers {
+		modifier.PlanModifyBool(ctx, planmodifier.BoolRequest{ConfigValue: types.BoolNull(), PlanValue: types.BoolUnknown()}, &slaNotificationResp)
+	}
+	assert.Equal(t, slaNotificationResp.PlanValue.ValueBool(), true)
+
+	// a configured value is kept
+	slaNotificationResp = planmodifier.BoolResponse{PlanValue: types.BoolValue(false)}
+	for _, modifier := range slaNotification.PlanModifiers {
+		modifier.PlanModifyBool(ctx, planmodifier.BoolRequest{ConfigValue: types.BoolValue(false), PlanValue: types.BoolValue(false)}, &slaNotificationResp)
+	}
+	assert.Equal(t, slaNotificationResp.PlanValue.ValueBool(), false)
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (t jiraProductConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo Jira Product Configuration. You can specify either the `product_id` or the `engagement_id` to look up the Jira Product Configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Product",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("engagement_id")),
+				},
+			},
+			"engagement_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Engagement",
+				Optional:            true,
+				Computed:            true,
+			},
+			"jira_instance_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Jira Instance",
+				Computed:            true,
+			},
+			"project_key": schema.StringAttribute{
+				MarkdownDescription: "The Jira Project Key",
+				Computed:            true,
+			},
+			"issue_template_dir": schema.StringAttribute{
+				MarkdownDescription: "The folder containing Django templates used to render the JIRA issue description",
+				Computed:            true,
+			},
+			"push_all_issues": schema.BoolAttribute{
+				MarkdownDescription: "Whether Jira tickets are always created and updated for the findings of the Product",
+				Computed:            true,
+			},
+			"enable_engagement_epic_mapping": schema.BoolAttribute{
+				MarkdownDescription: "Whether engagements are mapped to epics in Jira",
+				Computed:            true,
+			},
+			"push_notes": schema.BoolAttribute{
+				MarkdownDescription: "Whether notes are pushed to Jira",
+				Computed:            true,
+			},
+			"product_jira_sla_notification": schema.BoolAttribute{
+				MarkdownDescription: "Whether SLA notifications are sent as comments",
+				Computed:            true,
+			},
+			"risk_acceptance_expiration_notification": schema.BoolAttribute{
+				MarkdownDescription: "Whether Risk Acceptance expiration notifications are sent as comments",
+				Computed:            true,
+			},
+			"component": schema.StringAttribute{
+				MarkdownDescription: "The Jira component assigned to the issues",
+				Computed:            true,
+			},
+			"default_assignee": schema.StringAttribute{
+				MarkdownDescription: "The Jira user the issues are assigned to",
+				Computed:            true,
+			},
+			"jira_labels": schema.StringAttribute{
+				MarkdownDescription: "Space separated labels added to the issues",
+				Computed:            true,
+			},
+			"add_vulnerability_id_to_jira_label": schema.BoolAttribute{
+				MarkdownDescription: "Whether the vulnerability ids of a Finding are added as labels of its Jira issue",
+				Computed:            true,
+			},
+			"epic_issue_type_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira issue type used for epics",
+				Computed:            true,
+			},
+			"custom_fields": schema.StringAttribute{
+				MarkdownDescription: "A normalized JSON object of the Jira custom fields set on the issues",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type jiraProductConfigurationDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d jiraProductConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_product_configuration"
+}
+
+func NewJiraProductConfigurationDataSource() datasource.DataSource {
+	return &jiraProductConfigurationDataSource{}
+}
+
+func (r *jiraProductConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d jiraProductConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// the data source exposes the same attributes as the resource
+	var data jiraProductConfigurationResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var (
+		params dd.JiraProductConfigurationsListParams
+	)
+	if !data.Product.IsNull() {
+		idNumber, err := strconv.Atoi(data.Product.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Resource",
+				"The product_id field could not be parsed into an integer")
+			return
+		}
+		params.Product = &idNumber
+	}
+
+	if !data.Engagement.IsNull() {
+		idNumber, err := strconv.Atoi(data.Engagement.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Resource",
+				"The engagement_id field could not be parsed into an integer")
+			return
+		}
+		params.Engagement = &idNumber
+	}
+
+	apiResp, err := d.client.JiraProductConfigurationsListWithResponse(ctx, &params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+
+	if apiResp.StatusCode() == 200 {
+		if *apiResp.JSON200.Count == 0 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				"No Jira Product Configurations matched the given parameters.")
+			return
+		} else if *apiResp.JSON200.Count > 1 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				fmt.Sprintf("%d Jira Product Configurations matched the given parameters.\n\nResponse:\n\n%s", *apiResp.JSON200.Count, apiResp.Body))
+			return
+		}
+
+		// the fields the client doesn't know about are taken from the raw result
+		var page struct {
+			Results []json.RawMessage `json:"results"`
+		}
+		if err := json.Unmarshal(apiResp.Body, &page); err != nil || len(page.Results) != 1 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				fmt.Sprintf("Could not parse the response.\n\nResponse:\n\n%s", apiResp.Body))
+			return
+		}
+
+		ddJiraProject := &jiraProductConfigurationDefectdojoResource{}
+		if err := ddJiraProject.setFromResponse((*apiResp.JSON200.Results)[0], page.Results[0]); err != nil {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				fmt.Sprintf("%s", err))
+			return
+		}
+
+		var terraformResource terraformResourceData = &data
+		populateResourceData(ctx, &resp.Diagnostics, &terraformResource, ddJiraProject)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"API Error Retrieving Data Source",
+			fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+				fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProductConfigurationDataSource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	jirakey := fmt.Sprintf("APPSEC%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccJiraProductConfigurationDataSourceConfig(name, jirakey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_jira_product_configuration.test", "id", "defectdojo_jira_product_configuration.test", "id"),
+					resource.TestCheckResourceAttrPair("data.defectdojo_jira_product_configuration.test", "product_id", "defectdojo_product.test", "id"),
+					resource.TestCheckNoResourceAttr("data.defectdojo_jira_product_configuration.test", "engagement_id"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_product_configuration.test", "project_key", jirakey),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_product_configuration.test", "push_all_issues", "true"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_product_configuration.test", "push_notes", "false"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_product_configuration.test", "component", "Security"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_product_configuration.test", "jira_labels", "security defectdojo"),
+					resource.TestCheckResourceAttr("data.defectdojo_jira_product_configuration.test", "custom_fields", `{"customfield_10001":{"value":"High"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraProductConfigurationDataSourceNoMatch(t *testing.T) {
+	name := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Jira Product Configurations matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+data "defectdojo_jira_product_configuration" "test" {
+  product_id = defectdojo_product.test.id
+}
+`, name),
+			},
+		},
+	})
+}
+
+func testAccJiraProductConfigurationDataSourceConfig(productname string, name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_jira_product_configuration" "test" {
+  product_id = defectdojo_product.test.id
+  project_key = %[2]q
+  push_all_issues = true
+  component = "Security"
+  jira_labels = "security defectdojo"
+  custom_fields = jsonencode({ customfield_10001 = { value = "High" } })
+}
+data "defectdojo_jira_product_configuration" "test" {
+  product_id = defectdojo_product.test.id
+  depends_on = [defectdojo_jira_product_configuration.test]
+}
+`, productname, name)
+}
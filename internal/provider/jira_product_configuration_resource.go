@@ -1,15 +1,21 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
 	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -63,6 +69,62 @@ func (t *jiraProductConfigurationResource) Schema(ctx context.Context, req resou
 				Computed:            true,
 			},
 
+			"component": schema.StringAttribute{
+				MarkdownDescription: "The Jira component assigned to the issues created for this Product",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+
+			"default_assignee": schema.StringAttribute{
+				MarkdownDescription: "The Jira user the issues created for this Product are assigned to",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+
+			"jira_labels": schema.StringAttribute{
+				MarkdownDescription: "Space separated labels added to the issues created for this Product",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+
+			"add_vulnerability_id_to_jira_label": schema.BoolAttribute{
+				MarkdownDescription: "Whether to add the vulnerability ids of a Finding as labels of its Jira issue",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"epic_issue_type_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jira issue type used for epics. Only used when `enable_engagement_epic_mapping` is set. Defaults to 'Epic'.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringDefault("Epic"),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+				},
+			},
+
+			"custom_fields": schema.StringAttribute{
+				MarkdownDescription: "A JSON object of Jira custom fields set on the issues created for this Product, usually built with `jsonencode()`. Changes that only affect the formatting of the document are ignored. Values imported from DefectDojo are stored in a normalized form, with no whitespace and sorted keys.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalized(),
+				},
+				Validators: []validator.String{
+					jsonObject(),
+				},
+			},
+
 			"jira_instance_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the Jira Instance to use for this Product",
 				Optional:            true,
@@ -97,6 +159,12 @@ type jiraProductConfigurationResourceData struct {
 	PushNotes                            types.Bool   `tfsdk:"push_notes" ddField:"PushNotes"`
 	ProductJiraSlaNotification           types.Bool   `tfsdk:"product_jira_sla_notification" ddField:"ProductJiraSlaNotification"`
 	RiskAcceptanceExpirationNotification types.Bool   `tfsdk:"risk_acceptance_expiration_notification" ddField:"RiskAcceptanceExpirationNotification"`
+	Component                            types.String `tfsdk:"component" ddField:"Component"`
+	DefaultAssignee                      types.String `tfsdk:"default_assignee" ddField:"DefaultAssignee"`
+	JiraLabels                           types.String `tfsdk:"jira_labels" ddField:"JiraLabels"`
+	AddVulnerabilityIdToJiraLabel        types.Bool   `tfsdk:"add_vulnerability_id_to_jira_label" ddField:"AddVulnerabilityIdToJiraLabel"`
+	EpicIssueTypeName                    types.String `tfsdk:"epic_issue_type_name" ddField:"EpicIssueTypeName"`
+	CustomFields                         types.String `tfsdk:"custom_fields" ddField:"CustomFields"`
 	JiraInstance                         types.String `tfsdk:"jira_instance_id" ddField:"JiraInstance"`
 	Product                              types.String `tfsdk:"product_id" ddField:"Product"`
 	Engagement                           types.String `tfsdk:"engagement_id" ddField:"Engagement"`
@@ -105,13 +173,98 @@ type jiraProductConfigurationResourceData struct {
 
 type jiraProductConfigurationDefectdojoResource struct {
 	dd.JIRAProject
+	// these fields are not part of the client's JIRAProject yet
+	DefaultAssignee               *string
+	JiraLabels                    *string
+	AddVulnerabilityIdToJiraLabel *bool
+	EpicIssueTypeName             *string
+	CustomFields                  *string
+}
+
+type jiraProjectBody struct {
+	dd.JIRAProject
+	DefaultAssignee               *string         `json:"default_assignee"`
+	JiraLabels                    *string         `json:"jira_labels"`
+	AddVulnerabilityIdToJiraLabel *bool           `json:"add_vulnerability_id_to_jira_label,omitempty"`
+	EpicIssueTypeName             *string         `json:"epic_issue_type_name,omitempty"`
+	CustomFields                  json.RawMessage `json:"custom_fields,omitempty"`
+}
+
+func (ddr *jiraProductConfigurationDefectdojoResource) requestBody() (*bytes.Reader, error) {
+	jiraProject := jiraProjectBody{
+		JIRAProject:                   ddr.JIRAProject,
+		DefaultAssignee:               ddr.DefaultAssignee,
+		JiraLabels:                    ddr.JiraLabels,
+		AddVulnerabilityIdToJiraLabel: ddr.AddVulnerabilityIdToJiraLabel,
+		EpicIssueTypeName:             ddr.EpicIssueTypeName,
+	}
+	// fields left out of the configuration are cleared, the component can't be null though
+	if jiraProject.Component == nil {
+		jiraProject.Component = ref.Of("")
+	}
+	if ddr.CustomFields != nil && *ddr.CustomFields != "" {
+		jiraProject.CustomFields = json.RawMessage(*ddr.CustomFields)
+	} else {
+		jiraProject.CustomFields = json.RawMessage("null")
+	}
+	body, err := json.Marshal(jiraProject)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (ddr *jiraProductConfigurationDefectdojoResource) setFromResponse(jiraProject dd.JIRAProject, body []byte) error {
+	var extra jiraProjectBody
+	if err := json.Unmarshal(body, &extra); err != nil {
+		return err
+	}
+	ddr.JIRAProject = jiraProject
+	// empty values are the ones that aren't set
+	ddr.Component = nonEmptyString(jiraProject.Component)
+	ddr.DefaultAssignee = nonEmptyString(extra.DefaultAssignee)
+	ddr.JiraLabels = nonEmptyString(extra.JiraLabels)
+	ddr.AddVulnerabilityIdToJiraLabel = extra.AddVulnerabilityIdToJiraLabel
+	ddr.EpicIssueTypeName = extra.EpicIssueTypeName
+
+	if len(extra.CustomFields) == 0 || string(extra.CustomFields) == "null" {
+		ddr.CustomFields = nil
+		return nil
+	}
+	customFields, err := normalizeJson(extra.CustomFields)
+	if err != nil {
+		return err
+	}
+	// keep the configured document if it only differs in formatting
+	if ddr.CustomFields != nil {
+		if configured, err := normalizeJson([]byte(*ddr.CustomFields)); err == nil && configured == customFields {
+			return nil
+		}
+	}
+	ddr.CustomFields = &customFields
+	return nil
+}
+
+func nonEmptyString(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
 }
 
 func (ddr *jiraProductConfigurationDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
-	reqBody := dd.JiraProductConfigurationsCreateJSONRequestBody(ddr.JIRAProject)
-	apiResp, err := client.JiraProductConfigurationsCreateWithResponse(ctx, reqBody)
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.JiraProductConfigurationsCreateWithBodyWithResponse(ctx, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
 	if apiResp.JSON201 != nil {
-		ddr.JIRAProject = *apiResp.JSON201
+		if err := ddr.setFromResponse(*apiResp.JSON201, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
 	}
 
 	return apiResp.StatusCode(), apiResp.Body, err
@@ -119,18 +272,31 @@ func (ddr *jiraProductConfigurationDefectdojoResource) createApiCall(ctx context
 
 func (ddr *jiraProductConfigurationDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
 	apiResp, err := client.JiraProductConfigurationsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
 	if apiResp.JSON200 != nil {
-		ddr.JIRAProject = *apiResp.JSON200
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
 	}
 
 	return apiResp.StatusCode(), apiResp.Body, err
 }
 
 func (ddr *jiraProductConfigurationDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
-	reqBody := dd.JiraProductConfigurationsUpdateJSONRequestBody(ddr.JIRAProject)
-	apiResp, err := client.JiraProductConfigurationsUpdateWithResponse(ctx, idNumber, reqBody)
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.JiraProductConfigurationsUpdateWithBodyWithResponse(ctx, idNumber, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
 	if apiResp.JSON200 != nil {
-		ddr.JIRAProject = *apiResp.JSON200
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
 	}
 	return apiResp.StatusCode(), apiResp.Body, err
 }
@@ -104,6 +104,99 @@ func TestAccJiraProductConfigurationResourceInvalid(t *testing.T) {
 	})
 }
 
+func TestAccJiraProductConfigurationResourceJiraFields(t *testing.T) {
+	name := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	jirakey := fmt.Sprintf("APPSEC%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJiraProductConfigurationResourceJiraFieldsConfig(name, jirakey, `jsonencode({ customfield_10001 = { value = "High" } })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "component", "Security"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "default_assignee", "jdoe"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "jira_labels", "security defectdojo"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "add_vulnerability_id_to_jira_label", "true"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "epic_issue_type_name", "Initiative"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "custom_fields", `{"customfield_10001":{"value":"High"}}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_jira_product_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A document that only differs in formatting is kept as configured
+			{
+				Config: testAccJiraProductConfigurationResourceJiraFieldsConfig(name, jirakey, `<<EOT
+{
+  "customfield_10001": {"value": "High"}
+}
+EOT`),
+				PlanOnly: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccJiraProductConfigurationResourceJiraFieldsConfig(name, jirakey, `jsonencode({ customfield_10001 = { value = "Low" }, customfield_10002 = ["a", "b"] })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "custom_fields", `{"customfield_10001":{"value":"Low"},"customfield_10002":["a","b"]}`),
+				),
+			},
+			// Removing the fields clears them
+			{
+				Config: testAccJiraProductConfigurationResourceConfig(name, jirakey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("defectdojo_jira_product_configuration.test", "component"),
+					resource.TestCheckNoResourceAttr("defectdojo_jira_product_configuration.test", "default_assignee"),
+					resource.TestCheckNoResourceAttr("defectdojo_jira_product_configuration.test", "jira_labels"),
+					resource.TestCheckResourceAttr("defectdojo_jira_product_configuration.test", "epic_issue_type_name", "Epic"),
+					resource.TestCheckNoResourceAttr("defectdojo_jira_product_configuration.test", "custom_fields"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccJiraProductConfigurationResourceInvalidCustomFields(t *testing.T) {
+	name := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+Object`),
+				Config:      testAccJiraProductConfigurationResourceJiraFieldsConfig(name, "APPSEC", `jsonencode(["a", "b"])`),
+			},
+		},
+	})
+}
+
+func testAccJiraProductConfigurationResourceJiraFieldsConfig(productname string, name string, customFields string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_jira_product_configuration" "test" {
+  product_id = defectdojo_product.test.id
+  project_key = %[2]q
+  component = "Security"
+  default_assignee = "jdoe"
+  jira_labels = "security defectdojo"
+  add_vulnerability_id_to_jira_label = true
+  epic_issue_type_name = "Initiative"
+  custom_fields = %[3]s
+}
+`, productname, name, customFields)
+}
+
 func testAccInvalidJiraProductConfigurationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 provider "defectdojo" {}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestJiraProductConfigurationResourcePopulate(t *testing.T) {
+	expectedId := 99
+	expectedProjectKey := "APPSEC"
+	expectedComponent := "Security"
+	expectedDefaultAssignee := "jdoe"
+	expectedJiraLabels := "security defectdojo"
+	expectedAddVulnerabilityIdToJiraLabel := true
+	expectedEpicIssueTypeName := "Initiative"
+	expectedCustomFields := `{"customfield_10001":{"value":"High"}}`
+	expectedProductId := 42
+
+	ddJiraProject := jiraProductConfigurationDefectdojoResource{
+		JIRAProject: dd.JIRAProject{
+			Id:         expectedId,
+			ProjectKey: &expectedProjectKey,
+			Component:  &expectedComponent,
+			Product:    &expectedProductId,
+		},
+		DefaultAssignee:               &expectedDefaultAssignee,
+		JiraLabels:                    &expectedJiraLabels,
+		AddVulnerabilityIdToJiraLabel: &expectedAddVulnerabilityIdToJiraLabel,
+		EpicIssueTypeName:             &expectedEpicIssueTypeName,
+		CustomFields:                  &expectedCustomFields,
+	}
+
+	jiraProductConfigurationResource := jiraProductConfigurationResourceData{}
+	var terraformResource terraformResourceData = &jiraProductConfigurationResource
+
+	populateResourceData(context.Background(), &diag.Diagnostics{}, &terraformResource, &ddJiraProject)
+	assert.Equal(t, jiraProductConfigurationResource.Id.ValueString(), "99")
+	assert.Equal(t, jiraProductConfigurationResource.ProjectKey.ValueString(), expectedProjectKey)
+	assert.Equal(t, jiraProductConfigurationResource.Component.ValueString(), expectedComponent)
+	assert.Equal(t, jiraProductConfigurationResource.DefaultAssignee.ValueString(), expectedDefaultAssignee)
+	assert.Equal(t, jiraProductConfigurationResource.JiraLabels.ValueString(), expectedJiraLabels)
+	assert.Equal(t, jiraProductConfigurationResource.AddVulnerabilityIdToJiraLabel.ValueBool(), expectedAddVulnerabilityIdToJiraLabel)
+	assert.Equal(t, jiraProductConfigurationResource.EpicIssueTypeName.ValueString(), expectedEpicIssueTypeName)
+	assert.Equal(t, jiraProductConfigurationResource.CustomFields.ValueString(), expectedCustomFields)
+	assert.Equal(t, jiraProductConfigurationResource.Product.ValueString(), "42")
+	assert.Equal(t, jiraProductConfigurationResource.Engagement.IsNull(), true)
+
+	ddJiraProject = jiraProductConfigurationDefectdojoResource{
+		JIRAProject: dd.JIRAProject{},
+	}
+	populateResourceData(context.Background(), &diag.Diagnostics{}, &terraformResource, &ddJiraProject)
+
+	assert.Equal(t, jiraProductConfigurationResource.Component.IsNull(), true)
+	assert.Equal(t, jiraProductConfigurationResource.DefaultAssignee.IsNull(), true)
+	assert.Equal(t, jiraProductConfigurationResource.JiraLabels.IsNull(), true)
+	assert.Equal(t, jiraProductConfigurationResource.AddVulnerabilityIdToJiraLabel.IsNull(), true)
+	assert.Equal(t, jiraProductConfigurationResource.EpicIssueTypeName.IsNull(), true)
+	assert.Equal(t, jiraProductConfigurationResource.CustomFields.IsNull(), true)
+}
+
+func TestJiraProductConfigurationResource__defectdojoResource(t *testing.T) {
+	jiraProductConfigurationResource := jiraProductConfigurationResourceData{
+		ProjectKey:                    types.StringValue("APPSEC"),
+		Component:                     types.StringValue("Security"),
+		DefaultAssignee:               types.StringValue("jdoe"),
+		JiraLabels:                    types.StringValue("security defectdojo"),
+		AddVulnerabilityIdToJiraLabel: types.BoolValue(true),
+		EpicIssueTypeName:             types.StringValue("Initiative"),
+		CustomFields:                  types.StringValue(`{"customfield_10001": {"value": "High"}}`),
+		Product:                       types.StringValue("42"),
+		Engagement:                    types.StringNull(),
+	}
+
+	ddResource := jiraProductConfigurationResource.defectdojoResource()
+	ddJiraProject := ddResource.(*jiraProductConfigurationDefectdojoResource)
+	var terraformResource terraformResourceData = &jiraProductConfigurationResource
+	populateDefectdojoResource(context.Background(), &diag.Diagnostics{}, terraformResource, &ddResource)
+
+	assert.Equal(t, *ddJiraProject.ProjectKey, "APPSEC")
+	assert.Equal(t, *ddJiraProject.Component, "Security")
+	assert.Equal(t, *ddJiraProject.DefaultAssignee, "jdoe")
+	assert.Equal(t, *ddJiraProject.JiraLabels, "security defectdojo")
+	assert.Equal(t, *ddJiraProject.AddVulnerabilityIdToJiraLabel, true)
+	assert.Equal(t, *ddJiraProject.EpicIssueTypeName, "Initiative")
+	assert.Equal(t, *ddJiraProject.CustomFields, `{"customfield_10001": {"value": "High"}}`)
+	assert.Equal(t, *ddJiraProject.Product, 42)
+	assert.Assert(t, ddJiraProject.Engagement == nil)
+}
+
+func TestJiraProductConfigurationResource__requestBody(t *testing.T) {
+	ddJiraProject := jiraProductConfigurationDefectdojoResource{
+		JIRAProject: dd.JIRAProject{
+			ProjectKey: ref.Of("APPSEC"),
+			Product:    ref.Of(42),
+		},
+		JiraLabels:   ref.Of("security"),
+		CustomFields: ref.Of(`{"customfield_10001": {"value": "High"}}`),
+	}
+
+	reader, err := ddJiraProject.requestBody()
+	assert.NilError(t, err)
+	body, err := io.ReadAll(reader)
+	assert.NilError(t, err)
+
+	var sent map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	assert.Equal(t, sent["project_key"], "APPSEC")
+	assert.Equal(t, sent["product"], float64(42))
+	assert.Equal(t, sent["jira_labels"], "security")
+	assert.DeepEqual(t, sent["custom_fields"], map[string]interface{}{
+		"customfield_10001": map[string]interface{}{"value": "High"},
+	})
+	// unset values with a server side default are left out
+	_, ok := sent["epic_issue_type_name"]
+	assert.Equal(t, ok, false)
+	_, ok = sent["add_vulnerability_id_to_jira_label"]
+	assert.Equal(t, ok, false)
+
+	// values removed from the configuration are cleared
+	ddJiraProject.JiraLabels = nil
+	ddJiraProject.CustomFields = nil
+	reader, err = ddJiraProject.requestBody()
+	assert.NilError(t, err)
+	body, err = io.ReadAll(reader)
+	assert.NilError(t, err)
+	sent = map[string]interface{}{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	assert.Equal(t, sent["component"], "")
+	value, ok := sent["jira_labels"]
+	assert.Assert(t, ok && value == nil)
+	value, ok = sent["custom_fields"]
+	assert.Assert(t, ok && value == nil)
+}
+
+func TestJiraProductConfigurationResource__setFromResponse(t *testing.T) {
+	body := []byte(`{"id": 3, "project_key": "APPSEC", "product": 42, "engagement": null, "jira_instance": 1, "issue_template_dir": null, "component": "", "default_assignee": null, "jira_labels": "security", "add_vulnerability_id_to_jira_label": true, "epic_issue_type_name": "Epic", "custom_fields": {"b": 2, "a": {"value": "High"}}}`)
+	var jiraProject dd.JIRAProject
+	assert.NilError(t, json.Unmarshal(body, &jiraProject))
+
+	ddJiraProject := jiraProductConfigurationDefectdojoResource{}
+	assert.NilError(t, ddJiraProject.setFromResponse(jiraProject, body))
+	assert.Equal(t, ddJiraProject.Id, 3)
+	assert.Equal(t, *ddJiraProject.ProjectKey, "APPSEC")
+	// an empty component is one that isn't set
+	assert.Assert(t, ddJiraProject.Component == nil)
+	assert.Assert(t, ddJiraProject.DefaultAssignee == nil)
+	assert.Equal(t, *ddJiraProject.JiraLabels, "security")
+	assert.Equal(t, *ddJiraProject.AddVulnerabilityIdToJiraLabel, true)
+	assert.Equal(t, *ddJiraProject.EpicIssueTypeName, "Epic")
+	// custom fields read from the API are normalized
+	assert.Equal(t, *ddJiraProject.CustomFields, `{"a":{"value":"High"},"b":2}`)
+
+	// a configured document that only differs in formatting is kept as is
+	configured := "{\n  \"b\": 2,\n  \"a\": {\"value\": \"High\"}\n}"
+	ddJiraProject.CustomFields = &configured
+	assert.NilError(t, ddJiraProject.setFromResponse(jiraProject, body))
+	assert.Equal(t, *ddJiraProject.CustomFields, configured)
+
+	// but a different one is replaced
+	ddJiraProject.CustomFields = ref.Of(`{"a": 1}`)
+	assert.NilError(t, ddJiraProject.setFromResponse(jiraProject, body))
+	assert.Equal(t, *ddJiraProject.CustomFields, `{"a":{"value":"High"},"b":2}`)
+
+	// older versions of DefectDojo don't return the extra fields
+	assert.NilError(t, ddJiraProject.setFromResponse(jiraProject, []byte(`{"id": 3, "project_key": "APPSEC"}`)))
+	assert.Assert(t, ddJiraProject.JiraLabels == nil)
+	assert.Assert(t, ddJiraProject.CustomFields == nil)
+}
+
+func TestJiraProductConfigurationResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	(&jiraProductConfigurationResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	epicIssueType := schemaResp.Schema.Attributes["epic_issue_type_name"].(schema.StringAttribute)
+	epicIssueTypeResp := planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+	for _, modifier := range epicIssueType.PlanModifiers {
+		modifier.PlanModifyString(ctx, planmodifier.StringRequest{ConfigValue: types.StringNull(), PlanValue: types.StringUnknown()}, &epicIssueTypeResp)
+	}
+	assert.Equal(t, epicIssueTypeResp.PlanValue.ValueString(), "Epic")
+
+	// values that are cleared when removed from the configuration aren't computed
+	for _, name := range []string{"component", "default_assignee", "jira_labels", "custom_fields"} {
+		assert.Equal(t, schemaResp.Schema.Attributes[name].IsComputed(), false, name)
+	}
+}
+
+func TestJsonNormalizedModifier(t *testing.T) {
+	state := types.StringValue(`{"a":{"value":"High"},"b":2}`)
+	cases := map[string]struct {
+		config   types.String
+		state    types.String
+		expected types.String
+	}{
+		"reformatted": {
+			config:   types.StringValue("{\n  \"b\": 2,\n  \"a\": {\"value\": \"High\"}\n}"),
+			state:    state,
+			expected: state,
+		},
+		"changed": {
+			config:   types.StringValue(`{"a": 1}`),
+			state:    state,
+			expected: types.StringValue(`{"a": 1}`),
+		},
+		"created": {
+			config:   types.StringValue(`{"a": 1}`),
+			state:    types.StringNull(),
+			expected: types.StringValue(`{"a": 1}`),
+		},
+		"removed": {
+			config:   types.StringNull(),
+			state:    state,
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			config:   types.StringUnknown(),
+			state:    state,
+			expected: types.StringUnknown(),
+		},
+	}
+	for name, c := range cases {
+		resp := planmodifier.StringResponse{PlanValue: c.config}
+		jsonNormalized().PlanModifyString(context.Background(), planmodifier.StringRequest{
+			ConfigValue: c.config,
+			PlanValue:   c.config,
+			StateValue:  c.state,
+		}, &resp)
+		assert.Assert(t, resp.PlanValue.Equal(c.expected), name)
+	}
+}
+
+func TestJsonObjectValidator(t *testing.T) {
+	cases := map[string]bool{
+		`{"customfield_10001": {"value": "High"}}`: false,
+		`{}`:         false,
+		`["a", "b"]`: true,
+		`"a string"`: true,
+		`null`:       true,
+		`{"a": 1`:    true,
+		`not json`:   true,
+	}
+	for value, expectError := range cases {
+		resp := validator.StringResponse{}
+		jsonObject().ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("custom_fields"),
+			ConfigValue: types.StringValue(value),
+		}, &resp)
+		assert.Equal(t, resp.Diagnostics.HasError(), expectError, value)
+	}
+
+	resp := validator.StringResponse{}
+	jsonObject().ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("custom_fields"),
+		ConfigValue: types.StringNull(),
+	}, &resp)
+	assert.Equal(t, resp.Diagnostics.HasError(), false)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// membershipEndpoint is the path of the endpoint that manages one kind of membership, for example
+// `api/v2/product_members/`. The memberships of users and groups in products and product types only
+// differ in their endpoint and the type they are decoded into, so rather than four copies of the
+// same generated calls they are all made through rawApiCall.
+type membershipEndpoint string
+
+const (
+	productMembersPath     membershipEndpoint = "api/v2/product_members/"
+	productGroupsPath      membershipEndpoint = "api/v2/product_groups/"
+	productTypeMembersPath membershipEndpoint = "api/v2/product_type_members/"
+	productTypeGroupsPath  membershipEndpoint = "api/v2/product_type_groups/"
+)
+
+func (e membershipEndpoint) idPath(idNumber int) string {
+	return fmt.Sprintf("%s%d/", e, idNumber)
+}
+
+// create creates the membership, and on success replaces it with the one the server returned.
+func (e membershipEndpoint) create(ctx context.Context, client *dd.ClientWithResponses, membership interface{}) (int, []byte, error) {
+	return rawApiCall(ctx, client, http.MethodPost, string(e), nil, membership, membership)
+}
+
+func (e membershipEndpoint) read(ctx context.Context, client *dd.ClientWithResponses, idNumber int, membership interface{}) (int, []byte, error) {
+	return rawApiCall(ctx, client, http.MethodGet, e.idPath(idNumber), nil, nil, membership)
+}
+
+func (e membershipEndpoint) update(ctx context.Context, client *dd.ClientWithResponses, idNumber int, membership interface{}) (int, []byte, error) {
+	return rawApiCall(ctx, client, http.MethodPut, e.idPath(idNumber), nil, membership, membership)
+}
+
+func (e membershipEndpoint) delete(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return rawApiCall(ctx, client, http.MethodDelete, e.idPath(idNumber), nil, nil, nil)
+}
+
+// membershipLookup finds the id of the membership of a user or group (the principal) in a product
+// or product type (the parent). It returns nil if there is no such membership.
+type membershipLookup func(ctx context.Context, client *dd.ClientWithResponses, parentId int, principalId int) (*int, error)
+
+// parseCompositeId splits an import id of the form `<parent_id>:<principal_id>`.
+func parseCompositeId(id string) (int, int, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected an id of the form <parent_id>:<principal_id>, got %q", id)
+	}
+	parentId, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse %q as an id: %s", parts[0], err)
+	}
+	principalId, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse %q as an id: %s", parts[1], err)
+	}
+	return parentId, principalId, nil
+}
+
+// importMembershipState imports a membership either by its own id, or by a composite id of the
+// form `<parent_id>:<principal_id>` which is resolved to the id of the membership with lookup.
+func importMembershipState(ctx context.Context, client *dd.ClientWithResponses, lookup membershipLookup, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.Contains(req.ID, ":") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	parentId, principalId, err := parseCompositeId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not Import Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HTTP Client",
+			"Expected configured HTTP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	membershipId, err := lookup(ctx, client, parentId, principalId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+	if membershipId == nil {
+		resp.Diagnostics.AddError(
+			"Could not Import Resource",
+			fmt.Sprintf("No membership of %d in %d was found.", principalId, parentId))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(*membershipId)))...)
+}
+
+func unexpectedMembershipResponse(statusCode int, body []byte) error {
+	return fmt.Errorf("Unexpected response code from API: %d\n\nbody:\n\n%+v", statusCode, string(body))
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestParseCompositeId(t *testing.T) {
+	parentId, principalId, err := parseCompositeId("12:34")
+	assert.NilError(t, err)
+	assert.Equal(t, parentId, 12)
+	assert.Equal(t, principalId, 34)
+
+	_, _, err = parseCompositeId("12:34:56")
+	assert.ErrorContains(t, err, "expected an id of the form")
+
+	_, _, err = parseCompositeId("12:abc")
+	assert.ErrorContains(t, err, `could not parse "abc"`)
+}
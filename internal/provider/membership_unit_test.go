@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"gotest.tools/assert"
+)
+
+func TestMembershipEndpoint(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			var sent map[string]interface{}
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&sent))
+			assert.Equal(t, sent["product_type"], float64(2))
+			assert.Equal(t, sent["group"], float64(3))
+			if r.Method == http.MethodPost {
+				w.WriteHeader(201)
+			}
+			_, _ = w.Write([]byte(`{"id": 5, "product_type": 2, "group": 3, "role": 4}`))
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"id": 5, "product_type": 2, "group": 3, "role": 1}`))
+		case http.MethodDelete:
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+	ctx := context.Background()
+
+	ddr := &productTypeGroupDefectdojoResource{
+		ProductTypeGroup: dd.ProductTypeGroup{ProductType: 2, Group: 3, Role: 4},
+	}
+	statusCode, _, err := ddr.createApiCall(ctx, client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+	assert.Equal(t, ddr.Id, 5)
+
+	statusCode, _, err = ddr.readApiCall(ctx, client, 5)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 200)
+	assert.Equal(t, ddr.Role, 1)
+
+	statusCode, _, err = ddr.updateApiCall(ctx, client, 5)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 200)
+	assert.Equal(t, ddr.Role, 4)
+
+	statusCode, _, err = ddr.deleteApiCall(ctx, client, 5)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 204)
+
+	assert.DeepEqual(t, requests, []string{
+		"POST /api/v2/product_type_groups/",
+		"GET /api/v2/product_type_groups/5/",
+		"PUT /api/v2/product_type_groups/5/",
+		"DELETE /api/v2/product_type_groups/5/",
+	})
+}
+
+func TestMembershipResourcesTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &productMemberDefectdojoResource{})
+	assertTransportErrors(t, &productGroupDefectdojoResource{})
+	assertTransportErrors(t, &productTypeMemberDefectdojoResource{})
+	assertTransportErrors(t, &productTypeGroupDefectdojoResource{})
+}
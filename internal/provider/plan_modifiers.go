@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -32,8 +33,9 @@ func (m stringDefaultModifier) MarkdownDescription(ctx context.Context) string {
 // `resp` contains fields for updating the planned value, triggering resource
 // replacement, and returning diagnostics.
 func (m stringDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-	// If the value is unknown or known, do not set default value.
-	if !req.PlanValue.IsNull() {
+	// If the value is configured, unknown or known, do not set default value. The plan can't be
+	// checked instead, since unconfigured computed values are already marked as unknown in it.
+	if !req.ConfigValue.IsNull() {
 		return
 	}
 
@@ -70,8 +72,8 @@ func (m boolDefaultModifier) MarkdownDescription(ctx context.Context) string {
 // `resp` contains fields for updating the planned value, triggering resource
 // replacement, and returning diagnostics.
 func (m boolDefaultModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
-
-	if !req.PlanValue.IsNull() {
+	// If the value is configured, unknown or known, do not set default value.
+	if !req.ConfigValue.IsNull() {
 		return
 	}
 
@@ -83,3 +85,116 @@ func boolDefault(defaultValue bool) boolDefaultModifier {
 		Default: defaultValue,
 	}
 }
+
+// fileSha256Modifier is a plan modifier for a computed types.StringType
+// attribute that holds the SHA256 digest of a local file. The path of the file
+// is read from the FileAttribute attribute of the configuration. If
+// RequiresReplace is set, the resource is marked for replacement whenever the
+// digest of the file differs from the one in state.
+type fileSha256Modifier struct {
+	FileAttribute   string
+	RequiresReplace bool
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (m fileSha256Modifier) Description(ctx context.Context) string {
+	if m.RequiresReplace {
+		return fmt.Sprintf("Set to the SHA256 digest of the file at %s. If the digest changes, the resource will be replaced.", m.FileAttribute)
+	}
+	return fmt.Sprintf("Set to the SHA256 digest of the file at %s.", m.FileAttribute)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (m fileSha256Modifier) MarkdownDescription(ctx context.Context) string {
+	if m.RequiresReplace {
+		return fmt.Sprintf("Set to the SHA256 digest of the file at `%s`. If the digest changes, the resource will be replaced.", m.FileAttribute)
+	}
+	return fmt.Sprintf("Set to the SHA256 digest of the file at `%s`.", m.FileAttribute)
+}
+
+// PlanModifyString runs the logic of the plan modifier.
+// Access to the configuration, plan, and state is available in `req`, while
+// `resp` contains fields for updating the planned value, triggering resource
+// replacement, and returning diagnostics.
+func (m fileSha256Modifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var filePath types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.FileAttribute), &filePath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the path is not known yet, neither is the digest.
+	if filePath.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	// An optional file that isn't set has no digest.
+	if filePath.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	digest, err := fileSha256(filePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(m.FileAttribute),
+			"Could not Read File",
+			fmt.Sprintf("Error while computing the SHA256 digest of %s: %s", filePath.ValueString(), err))
+		return
+	}
+
+	resp.PlanValue = types.StringValue(digest)
+	if m.RequiresReplace && !req.StateValue.IsNull() && req.StateValue.ValueString() != digest {
+		resp.RequiresReplace = true
+	}
+}
+
+func fileSha256Of(fileAttribute string, requiresReplace bool) fileSha256Modifier {
+	return fileSha256Modifier{
+		FileAttribute:   fileAttribute,
+		RequiresReplace: requiresReplace,
+	}
+}
+
+// jsonNormalizedModifier is a plan modifier for a types.StringType attribute
+// that holds a JSON document. When the configured document only differs from
+// the one in state in its formatting, the value in state is planned instead,
+// so that reformatting the configuration doesn't cause an update.
+type jsonNormalizedModifier struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (m jsonNormalizedModifier) Description(ctx context.Context) string {
+	return "Changes to the formatting of the JSON document are ignored"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (m jsonNormalizedModifier) MarkdownDescription(ctx context.Context) string {
+	return "Changes to the formatting of the JSON document are ignored"
+}
+
+// PlanModifyString runs the logic of the plan modifier.
+// Access to the configuration, plan, and state is available in `req`, while
+// `resp` contains fields for updating the planned value, triggering resource
+// replacement, and returning diagnostics.
+func (m jsonNormalizedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+
+	configured, err := normalizeJson([]byte(req.ConfigValue.ValueString()))
+	if err != nil {
+		// the validators of the attribute report invalid documents
+		return
+	}
+	current, err := normalizeJson([]byte(req.StateValue.ValueString()))
+	if err != nil {
+		return
+	}
+	if configured == current {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func jsonNormalized() jsonNormalizedModifier {
+	return jsonNormalizedModifier{}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t productApiScanConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Product API Scan Configuration tells API-based imports, such as SonarQube API Import or BlackDuck API, which project of a Tool Configuration holds the findings of a Product. The meaning of the service keys depends on the tool.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product",
+				Required:            true,
+			},
+			"tool_configuration_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Tool Configuration",
+				Required:            true,
+			},
+			"service_key_1": schema.StringAttribute{
+				MarkdownDescription: "The first key identifying the project in the tool, for example the SonarQube project key",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"service_key_2": schema.StringAttribute{
+				MarkdownDescription: "The second key identifying the project in the tool, for example the SonarQube organization",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"service_key_3": schema.StringAttribute{
+				MarkdownDescription: "The third key identifying the project in the tool",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type productApiScanConfigurationResourceData struct {
+	ProductId           types.Int64  `tfsdk:"product_id" ddField:"Product"`
+	ToolConfigurationId types.Int64  `tfsdk:"tool_configuration_id" ddField:"ToolConfiguration"`
+	ServiceKey1         types.String `tfsdk:"service_key_1" ddField:"ServiceKey1"`
+	ServiceKey2         types.String `tfsdk:"service_key_2" ddField:"ServiceKey2"`
+	ServiceKey3         types.String `tfsdk:"service_key_3" ddField:"ServiceKey3"`
+	Id                  types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type productApiScanConfigurationDefectdojoResource struct {
+	dd.ProductAPIScanConfiguration
+}
+
+func (ddr *productApiScanConfigurationDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.ProductApiScanConfigurationsCreateJSONRequestBody(ddr.ProductAPIScanConfiguration)
+	apiResp, err := client.ProductApiScanConfigurationsCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.ProductAPIScanConfiguration = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *productApiScanConfigurationDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ProductApiScanConfigurationsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ProductAPIScanConfiguration = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *productApiScanConfigurationDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.ProductApiScanConfigurationsUpdateJSONRequestBody(ddr.ProductAPIScanConfiguration)
+	apiResp, err := client.ProductApiScanConfigurationsUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ProductAPIScanConfiguration = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *productApiScanConfigurationDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ProductApiScanConfigurationsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type productApiScanConfigurationResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &productApiScanConfigurationResource{}
+var _ resource.ResourceWithImportState = &productApiScanConfigurationResource{}
+
+func NewProductApiScanConfigurationResource() resource.Resource {
+	return &productApiScanConfigurationResource{
+		terraformResource: terraformResource{
+			dataProvider: productApiScanConfigurationDataProvider{},
+		},
+	}
+}
+
+func (r productApiScanConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_api_scan_configuration"
+}
+
+type productApiScanConfigurationDataProvider struct{}
+
+func (r productApiScanConfigurationDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data productApiScanConfigurationResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *productApiScanConfigurationResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *productApiScanConfigurationResourceData) defectdojoResource() defectdojoResource {
+	return &productApiScanConfigurationDefectdojoResource{
+		ProductAPIScanConfiguration: dd.ProductAPIScanConfiguration{},
+	}
+}
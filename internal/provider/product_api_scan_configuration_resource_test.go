@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProductApiScanConfigurationResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-api-scan-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductApiScanConfigurationResourceConfig(name, `service_key_1 = "example-service"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_1", "example-service"),
+					resource.TestCheckNoResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_2"),
+					resource.TestCheckNoResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_3"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_api_scan_configuration.test", "product_id", "defectdojo_product.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_api_scan_configuration.test", "tool_configuration_id", "defectdojo_tool_configuration.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_api_scan_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProductApiScanConfigurationResourceConfig(name, `
+  service_key_1 = "other-service"
+  service_key_2 = "doximity"
+  service_key_3 = "main"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_1", "other-service"),
+					resource.TestCheckResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_2", "doximity"),
+					resource.TestCheckResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_3", "main"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProductApiScanConfigurationResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductApiScanConfigurationResourceConfig(name, `service_key_1 = "example-service"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_api_scan_configuration.test", "service_key_1", "example-service"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccProductApiScanConfigurationResourceConfig(name, `service_key_1 = "example-service"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_product_api_scan_configuration.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductApiScanConfigurationResourceConfig(name string, keys string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_tool_type" "test" {
+  name = %[1]q
+}
+resource "defectdojo_tool_configuration" "test" {
+  name = %[1]q
+  tool_type_id = defectdojo_tool_type.test.id
+  url = "https://sonarqube.example.com/api"
+  authentication_type = "API"
+  api_key = "api-secret"
+}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_product_api_scan_configuration" "test" {
+  product_id = defectdojo_product.test.id
+  tool_configuration_id = defectdojo_tool_configuration.test.id
+  %[2]s
+}
+`, name, keys)
+}
@@ -90,6 +90,10 @@ func (t productDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "The ID of the user who is the manager for this product.",
 				Computed:            true,
 			},
+			"sla_configuration_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the SLA Configuration of this product.",
+				Computed:            true,
+			},
 			"regulation_ids": schema.SetAttribute{
 				MarkdownDescription: "The IDs of the Regulations which apply to this product.",
 				Computed:            true,
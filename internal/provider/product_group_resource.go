@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t productGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Product Group. Grants a Group a Role on a Product. It can be imported by its id, or by an id of the form `<product_id>:<group_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Group",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role the Group has on the Product",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type productGroupResourceData struct {
+	ProductId types.Int64  `tfsdk:"product_id" ddField:"Product"`
+	GroupId   types.Int64  `tfsdk:"group_id" ddField:"Group"`
+	RoleId    types.Int64  `tfsdk:"role_id" ddField:"Role"`
+	Id        types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type productGroupDefectdojoResource struct {
+	dd.ProductGroup
+}
+
+func (ddr *productGroupDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	return productGroupsPath.create(ctx, client, &ddr.ProductGroup)
+}
+
+func (ddr *productGroupDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productGroupsPath.read(ctx, client, idNumber, &ddr.ProductGroup)
+}
+
+func (ddr *productGroupDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productGroupsPath.update(ctx, client, idNumber, &ddr.ProductGroup)
+}
+
+func (ddr *productGroupDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productGroupsPath.delete(ctx, client, idNumber)
+}
+
+func lookupProductGroup(ctx context.Context, client *dd.ClientWithResponses, productId int, groupId int) (*int, error) {
+	apiResp, err := client.ProductGroupsListWithResponse(ctx, &dd.ProductGroupsListParams{ProductId: &productId, GroupId: &groupId})
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.JSON200 == nil {
+		return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+	}
+	if apiResp.JSON200.Results == nil || len(*apiResp.JSON200.Results) == 0 {
+		return nil, nil
+	}
+	return &(*apiResp.JSON200.Results)[0].Id, nil
+}
+
+type productGroupResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &productGroupResource{}
+var _ resource.ResourceWithImportState = &productGroupResource{}
+
+func NewProductGroupResource() resource.Resource {
+	return &productGroupResource{
+		terraformResource: terraformResource{
+			dataProvider: productGroupDataProvider{},
+		},
+	}
+}
+
+func (r productGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_group"
+}
+
+func (r productGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importMembershipState(ctx, r.client, lookupProductGroup, req, resp)
+}
+
+type productGroupDataProvider struct{}
+
+func (r productGroupDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data productGroupResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *productGroupResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *productGroupResourceData) defectdojoResource() defectdojoResource {
+	return &productGroupDefectdojoResource{
+		ProductGroup: dd.ProductGroup{},
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t productMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Product Member. Grants a User a Role on a Product. It can be imported by its id, or by an id of the form `<product_id>:<user_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the User",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role the User has on the Product",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type productMemberResourceData struct {
+	ProductId types.Int64  `tfsdk:"product_id" ddField:"Product"`
+	UserId    types.Int64  `tfsdk:"user_id" ddField:"User"`
+	RoleId    types.Int64  `tfsdk:"role_id" ddField:"Role"`
+	Id        types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type productMemberDefectdojoResource struct {
+	dd.ProductMember
+}
+
+func (ddr *productMemberDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	return productMembersPath.create(ctx, client, &ddr.ProductMember)
+}
+
+func (ddr *productMemberDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productMembersPath.read(ctx, client, idNumber, &ddr.ProductMember)
+}
+
+func (ddr *productMemberDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productMembersPath.update(ctx, client, idNumber, &ddr.ProductMember)
+}
+
+func (ddr *productMemberDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productMembersPath.delete(ctx, client, idNumber)
+}
+
+func lookupProductMember(ctx context.Context, client *dd.ClientWithResponses, productId int, userId int) (*int, error) {
+	apiResp, err := client.ProductMembersListWithResponse(ctx, &dd.ProductMembersListParams{ProductId: &productId, UserId: &userId})
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.JSON200 == nil {
+		return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+	}
+	if apiResp.JSON200.Results == nil || len(*apiResp.JSON200.Results) == 0 {
+		return nil, nil
+	}
+	return &(*apiResp.JSON200.Results)[0].Id, nil
+}
+
+type productMemberResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &productMemberResource{}
+var _ resource.ResourceWithImportState = &productMemberResource{}
+
+func NewProductMemberResource() resource.Resource {
+	return &productMemberResource{
+		terraformResource: terraformResource{
+			dataProvider: productMemberDataProvider{},
+		},
+	}
+}
+
+func (r productMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_member"
+}
+
+func (r productMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importMembershipState(ctx, r.client, lookupProductMember, req, resp)
+}
+
+type productMemberDataProvider struct{}
+
+func (r productMemberDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data productMemberResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *productMemberResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *productMemberResourceData) defectdojoResource() defectdojoResource {
+	return &productMemberDefectdojoResource{
+		ProductMember: dd.ProductMember{},
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProductMemberResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-product-member-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_product_member.test", "product_id", "defectdojo_product.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_member.test", "user_id", "defectdojo_user.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_product_member.test", "role_id", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing with a composite id
+			{
+				ResourceName:      "defectdojo_product_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["defectdojo_product_member.test"].Primary.Attributes
+					return fmt.Sprintf("%s:%s", attributes["product_id"], attributes["user_id"]), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProductMemberResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_member.test", "role_id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProductMemberResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_member.test", "role_id", "5"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccProductMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_product_member.test"),
+				),
+			},
+			{
+				Config: testAccProductMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_member.test", "role_id", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProductMemberResourceConfig(name string, roleId int) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_user" "test" {
+  username = %[1]q
+  email = "%[1]s@example.com"
+}
+resource "defectdojo_product_member" "test" {
+  product_id = defectdojo_product.test.id
+  user_id = defectdojo_user.test.id
+  role_id = %[2]d
+}
+`, name, roleId)
+}
@@ -0,0 +1,526 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t productMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authoritative set of the User and Group memberships of a DefectDojo Product. Memberships of the Product that are not listed here are reported when refreshing and removed on apply, so this resource must not be combined with `defectdojo_product_member` or `defectdojo_product_group` for the same Product. The membership of the User the provider authenticates as, which DefectDojo gives to whoever creates the Product, is only managed when that User is listed in `users`, so that the provider doesn't take away its own access. Destroying this resource removes the memberships it lists, and leaves any others in place. It can be imported by the id of the Product.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetNestedAttribute{
+				MarkdownDescription: "The Users that are members of the Product",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the User",
+							Required:            true,
+						},
+						"role_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the Role the User has on the Product",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"groups": schema.SetNestedAttribute{
+				MarkdownDescription: "The Groups that are members of the Product",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the Group",
+							Required:            true,
+						},
+						"role_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the Role the Group has on the Product",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier, the same as `product_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type productMembersResourceData struct {
+	ProductId types.Int64  `tfsdk:"product_id" ddField:"ProductId"`
+	Users     types.Set    `tfsdk:"users" ddField:"Users"`
+	Groups    types.Set    `tfsdk:"groups" ddField:"Groups"`
+	Id        types.String `tfsdk:"id" ddField:"Id"`
+}
+
+var productMembersUserAttrTypes = map[string]attr.Type{
+	"user_id": types.Int64Type,
+	"role_id": types.Int64Type,
+}
+
+var productMembersGroupAttrTypes = map[string]attr.Type{
+	"group_id": types.Int64Type,
+	"role_id":  types.Int64Type,
+}
+
+type productMembersDefectdojoResource struct {
+	Id        int
+	ProductId int
+	Users     types.Set
+	Groups    types.Set
+}
+
+// membershipRoles returns the role of each principal (user or group) in a set of memberships, keyed by the
+// id of the principal.
+func membershipRoles(memberships types.Set, principalAttribute string) (map[int]int, error) {
+	roles := map[int]int{}
+	if memberships.IsNull() || memberships.IsUnknown() {
+		return roles, nil
+	}
+	for _, element := range memberships.Elements() {
+		attributes := element.(types.Object).Attributes()
+		principalId := int(attributes[principalAttribute].(types.Int64).ValueInt64())
+		roleId := int(attributes["role_id"].(types.Int64).ValueInt64())
+		if _, ok := roles[principalId]; ok {
+			return nil, fmt.Errorf("%s %d is listed more than once", principalAttribute, principalId)
+		}
+		roles[principalId] = roleId
+	}
+	return roles, nil
+}
+
+// membershipSet converts the role of each principal back to a set of memberships. An empty set is
+// returned as null, unless the previous value was an empty set as well.
+func membershipSet(roles map[int]int, principalAttribute string, attrTypes map[string]attr.Type, previous types.Set) types.Set {
+	elementType := types.ObjectType{AttrTypes: attrTypes}
+	if len(roles) == 0 && (previous.IsNull() || previous.IsUnknown()) {
+		return types.SetNull(elementType)
+	}
+	elements := []attr.Value{}
+	for principalId, roleId := range roles {
+		elements = append(elements, types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			principalAttribute: types.Int64Value(int64(principalId)),
+			"role_id":          types.Int64Value(int64(roleId)),
+		}))
+	}
+	return types.SetValueMust(elementType, elements)
+}
+
+func (ddr *productMembersDefectdojoResource) listProductMembers(ctx context.Context, client *dd.ClientWithResponses) ([]dd.ProductMember, error) {
+	members := []dd.ProductMember{}
+	for offset := 0; ; offset += listPageSize {
+		apiResp, err := client.ProductMembersListWithResponse(ctx, &dd.ProductMembersListParams{
+			ProductId: &ddr.ProductId,
+			Limit:     ref.Of(listPageSize),
+			Offset:    ref.Of(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if apiResp.JSON200 == nil {
+			return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+		}
+		if apiResp.JSON200.Results != nil {
+			members = append(members, *apiResp.JSON200.Results...)
+		}
+		if apiResp.JSON200.Next == nil {
+			return members, nil
+		}
+	}
+}
+
+func (ddr *productMembersDefectdojoResource) listProductGroups(ctx context.Context, client *dd.ClientWithResponses) ([]dd.ProductGroup, error) {
+	groups := []dd.ProductGroup{}
+	for offset := 0; ; offset += listPageSize {
+		apiResp, err := client.ProductGroupsListWithResponse(ctx, &dd.ProductGroupsListParams{
+			ProductId: &ddr.ProductId,
+			Limit:     ref.Of(listPageSize),
+			Offset:    ref.Of(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if apiResp.JSON200 == nil {
+			return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+		}
+		if apiResp.JSON200.Results != nil {
+			groups = append(groups, *apiResp.JSON200.Results...)
+		}
+		if apiResp.JSON200.Next == nil {
+			return groups, nil
+		}
+	}
+}
+
+// unmanagedUser returns the id of the user the provider authenticates as, unless that user is listed in
+// Users. DefectDojo makes the creator of a product its owner, and removing that membership could lock
+// the provider out of the product, so it is left alone.
+func (ddr *productMembersDefectdojoResource) unmanagedUser(ctx context.Context, client *dd.ClientWithResponses) (*int, error) {
+	roles, err := membershipRoles(ddr.Users, "user_id")
+	if err != nil {
+		return nil, err
+	}
+	apiResp, err := client.UserProfileRetrieveWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.JSON200 == nil {
+		return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+	}
+	userId := apiResp.JSON200.User.Id
+	if _, ok := roles[userId]; ok {
+		return nil, nil
+	}
+	return &userId, nil
+}
+
+// syncUsers creates, updates and removes user memberships of the product until they match Users.
+func (ddr *productMembersDefectdojoResource) syncUsers(ctx context.Context, client *dd.ClientWithResponses) error {
+	desired, err := membershipRoles(ddr.Users, "user_id")
+	if err != nil {
+		return err
+	}
+	existing, err := ddr.listProductMembers(ctx, client)
+	if err != nil {
+		return err
+	}
+	unmanaged, err := ddr.unmanagedUser(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range existing {
+		if unmanaged != nil && member.User == *unmanaged {
+			continue
+		}
+		roleId, ok := desired[member.User]
+		if !ok {
+			apiResp, err := client.ProductMembersDestroyWithResponse(ctx, member.Id)
+			if err != nil {
+				return err
+			}
+			if apiResp.StatusCode() != 204 {
+				return unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+			}
+			continue
+		}
+		delete(desired, member.User)
+		if member.Role != roleId {
+			member.Role = roleId
+			apiResp, err := client.ProductMembersUpdateWithResponse(ctx, member.Id, dd.ProductMembersUpdateJSONRequestBody(member))
+			if err != nil {
+				return err
+			}
+			if apiResp.StatusCode() != 200 {
+				return unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+			}
+		}
+	}
+
+	for userId, roleId := range desired {
+		apiResp, err := client.ProductMembersCreateWithResponse(ctx, dd.ProductMembersCreateJSONRequestBody{
+			Product: ddr.ProductId,
+			User:    userId,
+			Role:    roleId,
+		})
+		if err != nil {
+			return err
+		}
+		if apiResp.StatusCode() != 201 {
+			return unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+		}
+	}
+	return nil
+}
+
+// syncGroups creates, updates and removes group memberships of the product until they match Groups.
+func (ddr *productMembersDefectdojoResource) syncGroups(ctx context.Context, client *dd.ClientWithResponses) error {
+	desired, err := membershipRoles(ddr.Groups, "group_id")
+	if err != nil {
+		return err
+	}
+	existing, err := ddr.listProductGroups(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range existing {
+		roleId, ok := desired[group.Group]
+		if !ok {
+			apiResp, err := client.ProductGroupsDestroyWithResponse(ctx, group.Id)
+			if err != nil {
+				return err
+			}
+			if apiResp.StatusCode() != 204 {
+				return unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+			}
+			continue
+		}
+		delete(desired, group.Group)
+		if group.Role != roleId {
+			group.Role = roleId
+			apiResp, err := client.ProductGroupsUpdateWithResponse(ctx, group.Id, dd.ProductGroupsUpdateJSONRequestBody(group))
+			if err != nil {
+				return err
+			}
+			if apiResp.StatusCode() != 200 {
+				return unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+			}
+		}
+	}
+
+	for groupId, roleId := range desired {
+		apiResp, err := client.ProductGroupsCreateWithResponse(ctx, dd.ProductGroupsCreateJSONRequestBody{
+			Product: ddr.ProductId,
+			Group:   groupId,
+			Role:    roleId,
+		})
+		if err != nil {
+			return err
+		}
+		if apiResp.StatusCode() != 201 {
+			return unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+		}
+	}
+	return nil
+}
+
+func (ddr *productMembersDefectdojoResource) sync(ctx context.Context, client *dd.ClientWithResponses) error {
+	if err := ddr.syncUsers(ctx, client); err != nil {
+		return err
+	}
+	if err := ddr.syncGroups(ctx, client); err != nil {
+		return err
+	}
+	ddr.Id = ddr.ProductId
+	return nil
+}
+
+func (ddr *productMembersDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	if err := ddr.sync(ctx, client); err != nil {
+		return 0, nil, err
+	}
+	// the memberships are created one at a time, so there is no single response to report
+	return 201, nil, nil
+}
+
+func (ddr *productMembersDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// the product is read first, so that a deleted product removes this resource from state
+	apiResp, err := client.ProductsRetrieveWithResponse(ctx, idNumber, &dd.ProductsRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.StatusCode() != 200 {
+		return apiResp.StatusCode(), apiResp.Body, nil
+	}
+
+	ddr.Id = idNumber
+	ddr.ProductId = idNumber
+
+	members, err := ddr.listProductMembers(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+	unmanaged, err := ddr.unmanagedUser(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+	userRoles := map[int]int{}
+	for _, member := range members {
+		if unmanaged != nil && member.User == *unmanaged {
+			continue
+		}
+		userRoles[member.User] = member.Role
+	}
+	ddr.Users = membershipSet(userRoles, "user_id", productMembersUserAttrTypes, ddr.Users)
+
+	groups, err := ddr.listProductGroups(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+	groupRoles := map[int]int{}
+	for _, group := range groups {
+		groupRoles[group.Group] = group.Role
+	}
+	ddr.Groups = membershipSet(groupRoles, "group_id", productMembersGroupAttrTypes, ddr.Groups)
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *productMembersDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	if err := ddr.sync(ctx, client); err != nil {
+		return 0, nil, err
+	}
+	return 200, nil, nil
+}
+
+func (ddr *productMembersDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// only the memberships listed in the state are removed, any others (like the owner's) are left in
+	// place
+	ddr.ProductId = idNumber
+	users, err := membershipRoles(ddr.Users, "user_id")
+	if err != nil {
+		return 0, nil, err
+	}
+	members, err := ddr.listProductMembers(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, member := range members {
+		if _, ok := users[member.User]; !ok {
+			continue
+		}
+		apiResp, err := client.ProductMembersDestroyWithResponse(ctx, member.Id)
+		if err != nil {
+			return 0, nil, err
+		}
+		if apiResp.StatusCode() != 204 {
+			return apiResp.StatusCode(), apiResp.Body, nil
+		}
+	}
+
+	groups, err := membershipRoles(ddr.Groups, "group_id")
+	if err != nil {
+		return 0, nil, err
+	}
+	productGroups, err := ddr.listProductGroups(ctx, client)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, group := range productGroups {
+		if _, ok := groups[group.Group]; !ok {
+			continue
+		}
+		apiResp, err := client.ProductGroupsDestroyWithResponse(ctx, group.Id)
+		if err != nil {
+			return 0, nil, err
+		}
+		if apiResp.StatusCode() != 204 {
+			return apiResp.StatusCode(), apiResp.Body, nil
+		}
+	}
+	return 204, nil, nil
+}
+
+type productMembersResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &productMembersResource{}
+var _ resource.ResourceWithImportState = &productMembersResource{}
+
+func NewProductMembersResource() resource.Resource {
+	return &productMembersResource{
+		terraformResource: terraformResource{
+			dataProvider: productMembersDataProvider{},
+		},
+	}
+}
+
+func (r productMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_members"
+}
+
+func (r productMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var prior productMembersResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.terraformResource.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+
+	// right after an import only the id is known, so there is nothing to compare with
+	if prior.ProductId.IsNull() {
+		return
+	}
+
+	var current productMembersResourceData
+	resp.Diagnostics.Append(resp.State.Get(ctx, &current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	extra := append(
+		unmanagedMemberships(current.Users, prior.Users, "user"),
+		unmanagedMemberships(current.Groups, prior.Groups, "group")...)
+	if len(extra) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unmanaged Product Memberships Found",
+			fmt.Sprintf("The following memberships of Product %d are not managed by Terraform and will be removed on the next apply:\n\n%s",
+				current.ProductId.ValueInt64(), strings.Join(extra, "\n")))
+	}
+}
+
+// unmanagedMemberships describes the memberships found in current but not in prior.
+func unmanagedMemberships(current types.Set, prior types.Set, principal string) []string {
+	known := map[string]bool{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		for _, element := range prior.Elements() {
+			known[element.String()] = true
+		}
+	}
+	extra := []string{}
+	if !current.IsNull() && !current.IsUnknown() {
+		for _, element := range current.Elements() {
+			if !known[element.String()] {
+				attributes := element.(types.Object).Attributes()
+				extra = append(extra, fmt.Sprintf("  - %s %s with role %s", principal, attributes[principal+"_id"], attributes["role_id"]))
+			}
+		}
+	}
+	sort.Strings(extra)
+	return extra
+}
+
+type productMembersDataProvider struct{}
+
+func (r productMembersDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data productMembersResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *productMembersResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *productMembersResourceData) defectdojoResource() defectdojoResource {
+	// the memberships are needed by the delete, which isn't given the state
+	return &productMembersDefectdojoResource{
+		Users:  d.Users,
+		Groups: d.Groups,
+	}
+}
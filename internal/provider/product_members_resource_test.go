@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProductMembersResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-members-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductMembersResourceConfig(name, "first", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_product_members.test", "product_id", "defectdojo_product.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_members.test", "id", "defectdojo_product.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_product_members.test", "users.#", "1"),
+					resource.TestCheckResourceAttr("defectdojo_product_members.test", "groups.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A membership added outside of Terraform shows up in the plan
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccProductMembersResourceConfig(name, "first", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAddProductMemberOutsideTerraform("defectdojo_product.test", "defectdojo_user.second"),
+				),
+			},
+			// ... and is removed on apply, along with the role change and the switch of users
+			{
+				Config: testAccProductMembersResourceConfig(name, "second", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_members.test", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("defectdojo_product_members.test", "users.*.user_id", "defectdojo_user.second", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("defectdojo_product_members.test", "users.*", map[string]string{"role_id": "2"}),
+					resource.TestCheckResourceAttr("defectdojo_product_members.test", "groups.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAddProductMemberOutsideTerraform(productName string, userName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		productId, err := strconv.Atoi(s.RootModule().Resources[productName].Primary.ID)
+		if err != nil {
+			return err
+		}
+		userId, err := strconv.Atoi(s.RootModule().Resources[userName].Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient(context.Background(), os.Getenv("DEFECTDOJO_BASEURL"), os.Getenv("DEFECTDOJO_APIKEY"), os.Getenv("DEFECTDOJO_USERNAME"), os.Getenv("DEFECTDOJO_PASSWORD"))
+		if err != nil {
+			return err
+		}
+		resp, err := client.ProductMembersCreate(context.Background(), dd.ProductMembersCreateJSONRequestBody{
+			Product: productId,
+			User:    userId,
+			Role:    5,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != 201 {
+			return fmt.Errorf("bad status code creating the membership: %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+func testAccProductMembersResourceConfig(name string, member string, roleId int) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_user" "first" {
+  username = "%[1]s-first"
+  email = "%[1]s-first@example.com"
+}
+resource "defectdojo_user" "second" {
+  username = "%[1]s-second"
+  email = "%[1]s-second@example.com"
+}
+resource "defectdojo_dojo_group" "test" {
+  name = %[1]q
+}
+resource "defectdojo_product_members" "test" {
+  product_id = defectdojo_product.test.id
+
+  users = [
+    {
+      user_id = defectdojo_user.%[2]s.id
+      role_id = %[3]d
+    },
+  ]
+
+  groups = [
+    {
+      group_id = defectdojo_dojo_group.test.id
+      role_id = 5
+    },
+  ]
+}
+`, name, member, roleId)
+}
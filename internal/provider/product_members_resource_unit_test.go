@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func testUserMembership(userId int64, roleId int64) attr.Value {
+	return types.ObjectValueMust(productMembersUserAttrTypes, map[string]attr.Value{
+		"user_id": types.Int64Value(userId),
+		"role_id": types.Int64Value(roleId),
+	})
+}
+
+func TestMembershipRoles(t *testing.T) {
+	elementType := types.ObjectType{AttrTypes: productMembersUserAttrTypes}
+
+	roles, err := membershipRoles(types.SetNull(elementType), "user_id")
+	assert.NilError(t, err)
+	assert.Equal(t, len(roles), 0)
+
+	roles, err = membershipRoles(types.SetValueMust(elementType, []attr.Value{testUserMembership(1, 5), testUserMembership(2, 4)}), "user_id")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, roles, map[int]int{1: 5, 2: 4})
+
+	_, err = membershipRoles(types.SetValueMust(elementType, []attr.Value{testUserMembership(1, 5), testUserMembership(1, 4)}), "user_id")
+	assert.ErrorContains(t, err, "user_id 1 is listed more than once")
+}
+
+func TestMembershipSet(t *testing.T) {
+	elementType := types.ObjectType{AttrTypes: productMembersUserAttrTypes}
+
+	// no memberships stay null, or empty if they were empty before
+	assert.Assert(t, membershipSet(map[int]int{}, "user_id", productMembersUserAttrTypes, types.SetNull(elementType)).IsNull())
+	empty := membershipSet(map[int]int{}, "user_id", productMembersUserAttrTypes, types.SetValueMust(elementType, []attr.Value{}))
+	assert.Equal(t, empty.IsNull(), false)
+	assert.Equal(t, len(empty.Elements()), 0)
+
+	set := membershipSet(map[int]int{1: 5, 2: 4}, "user_id", productMembersUserAttrTypes, types.SetNull(elementType))
+	assert.Assert(t, set.Equal(types.SetValueMust(elementType, []attr.Value{testUserMembership(2, 4), testUserMembership(1, 5)})))
+}
+
+func TestUnmanagedMemberships(t *testing.T) {
+	elementType := types.ObjectType{AttrTypes: productMembersUserAttrTypes}
+	prior := types.SetValueMust(elementType, []attr.Value{testUserMembership(1, 5)})
+	current := types.SetValueMust(elementType, []attr.Value{testUserMembership(1, 5), testUserMembership(3, 4), testUserMembership(2, 5)})
+
+	assert.DeepEqual(t, unmanagedMemberships(current, prior, "user"), []string{
+		"  - user 2 with role 5",
+		"  - user 3 with role 4",
+	})
+	assert.Equal(t, len(unmanagedMemberships(prior, prior, "user")), 0)
+}
+
+func TestProductMembersResourceDeleteOnlyRemovesListed(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/product_members/":
+			// user 1 is the owner of the product, which this resource doesn't list
+			_, _ = w.Write([]byte(`{"count": 2, "next": null, "results": [{"id": 10, "product": 7, "user": 1, "role": 4}, {"id": 11, "product": 7, "user": 2, "role": 5}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/product_groups/":
+			_, _ = w.Write([]byte(`{"count": 1, "next": null, "results": [{"id": 20, "product": 7, "group": 3, "role": 5}]}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	data := productMembersResourceData{
+		Users:  types.SetValueMust(types.ObjectType{AttrTypes: productMembersUserAttrTypes}, []attr.Value{testUserMembership(2, 5)}),
+		Groups: types.SetNull(types.ObjectType{AttrTypes: productMembersGroupAttrTypes}),
+	}
+	statusCode, _, err := data.defectdojoResource().deleteApiCall(context.Background(), client, 7)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 204)
+	assert.DeepEqual(t, deleted, []string{"/api/v2/product_members/11/"})
+}
+
+func TestProductMembersResourceReadTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+	server.Close()
+
+	ddMembers := productMembersDefectdojoResource{}
+	_, _, err = ddMembers.readApiCall(context.Background(), client, 7)
+	assert.Assert(t, err != nil)
+}
+
+// productMembersServer serves a product that user 1, who the provider authenticates as, created and so
+// owns, and that user 3 is also a member of.
+func productMembersServer(requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/user_profile/":
+			_, _ = w.Write([]byte(`{"user": {"id": 1, "username": "admin"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/products/7/":
+			_, _ = w.Write([]byte(`{"id": 7, "name": "A Product"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/product_members/":
+			_, _ = w.Write([]byte(`{"count": 2, "next": null, "results": [{"id": 10, "product": 7, "user": 1, "role": 4}, {"id": 11, "product": 7, "user": 3, "role": 5}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/product_groups/":
+			_, _ = w.Write([]byte(`{"count": 0, "next": null, "results": []}`))
+		case r.Method == http.MethodPost:
+			*requests = append(*requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id": 12, "product": 7, "user": 2, "role": 5}`))
+		default:
+			*requests = append(*requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(204)
+		}
+	}))
+}
+
+func TestProductMembersResourceLeavesTheOwnMembershipAlone(t *testing.T) {
+	var requests []string
+	server := productMembersServer(&requests)
+	defer server.Close()
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+	elementType := types.ObjectType{AttrTypes: productMembersUserAttrTypes}
+
+	ddMembers := productMembersDefectdojoResource{
+		ProductId: 7,
+		Users:     types.SetValueMust(elementType, []attr.Value{testUserMembership(2, 5)}),
+		Groups:    types.SetNull(types.ObjectType{AttrTypes: productMembersGroupAttrTypes}),
+	}
+	statusCode, _, err := ddMembers.createApiCall(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+	// the membership of user 1 is kept, the one of user 3 isn't listed and so is removed
+	assert.DeepEqual(t, requests, []string{
+		"DELETE /api/v2/product_members/11/",
+		"POST /api/v2/product_members/",
+	})
+
+	// nor is it reported when reading
+	_, _, err = ddMembers.readApiCall(context.Background(), client, 7)
+	assert.NilError(t, err)
+	assert.Assert(t, ddMembers.Users.Equal(types.SetValueMust(elementType, []attr.Value{testUserMembership(3, 5)})))
+
+	// unless it is listed
+	ddMembers.Users = types.SetValueMust(elementType, []attr.Value{testUserMembership(1, 4)})
+	_, _, err = ddMembers.readApiCall(context.Background(), client, 7)
+	assert.NilError(t, err)
+	assert.Assert(t, ddMembers.Users.Equal(types.SetValueMust(elementType, []attr.Value{testUserMembership(1, 4), testUserMembership(3, 5)})))
+}
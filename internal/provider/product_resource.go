@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -107,11 +110,11 @@ func (t productResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"enable_full_risk_acceptance": schema.BoolAttribute{
-				MarkdownDescription: "Allows full risk acceptance using a risk acceptance form, expiration date, uploaded proof, etc.",
+				MarkdownDescription: "Allows full risk acceptance using a risk acceptance form, expiration date, uploaded proof, etc. Defaults to `true`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Bool{
-					boolDefault(false),
+					boolDefault(true),
 				},
 			},
 			"product_manager_id": schema.Int64Attribute{
@@ -126,6 +129,14 @@ func (t productResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The ID of the user who is the manager for this product.",
 				Optional:            true,
 			},
+			"sla_configuration_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the SLA Configuration of this product. Defaults to the default SLA Configuration of DefectDojo.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"regulation_ids": schema.SetAttribute{
 				MarkdownDescription: "The IDs of the Regulations which apply to this product.",
 				Optional:            true,
@@ -177,19 +188,56 @@ type productResourceData struct {
 	TeamManagerId              types.Int64  `tfsdk:"team_manager_id" ddField:"TeamManager"`
 	TechnicalContactId         types.Int64  `tfsdk:"technical_contact_id" ddField:"TechnicalContact"`
 	UserRecords                types.Int64  `tfsdk:"user_records" ddField:"UserRecords"`
+	SlaConfigurationId         types.Int64  `tfsdk:"sla_configuration_id" ddField:"SlaConfiguration"`
 }
 
 type productDefectdojoResource struct {
 	dd.Product
+	// the client's Product doesn't know about the SLA configuration yet
+	SlaConfiguration *int
+}
+
+type productBody struct {
+	dd.Product
+	SlaConfiguration *int `json:"sla_configuration,omitempty"`
+}
+
+func (ddr *productDefectdojoResource) requestBody() (*bytes.Reader, error) {
+	body, err := json.Marshal(productBody{
+		Product:          ddr.Product,
+		SlaConfiguration: ddr.SlaConfiguration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (ddr *productDefectdojoResource) setFromResponse(product dd.Product, body []byte) error {
+	var extra productBody
+	if err := json.Unmarshal(body, &extra); err != nil {
+		return err
+	}
+	ddr.Product = product
+	ddr.SlaConfiguration = extra.SlaConfiguration
+	return nil
 }
 
 func (ddr *productDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
 	tflog.Info(ctx, "createApiCall")
-	reqBody := dd.ProductsCreateJSONRequestBody(ddr.Product)
-	apiResp, err := client.ProductsCreateWithResponse(ctx, reqBody)
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.ProductsCreateWithBodyWithResponse(ctx, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
 	tflog.Info(ctx, fmt.Sprintf("response %s: %s", apiResp.Status(), apiResp.Body))
 	if apiResp.JSON201 != nil {
-		ddr.Product = *apiResp.JSON201
+		if err := ddr.setFromResponse(*apiResp.JSON201, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
 	}
 
 	return apiResp.StatusCode(), apiResp.Body, err
@@ -198,9 +246,14 @@ func (ddr *productDefectdojoResource) createApiCall(ctx context.Context, client
 func (ddr *productDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
 	tflog.Info(ctx, "readApiCall")
 	apiResp, err := client.ProductsRetrieveWithResponse(ctx, idNumber, &dd.ProductsRetrieveParams{})
+	if err != nil {
+		return 0, nil, err
+	}
 	tflog.Info(ctx, fmt.Sprintf("response %s: %s", apiResp.Status(), apiResp.Body))
 	if apiResp.JSON200 != nil {
-		ddr.Product = *apiResp.JSON200
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
 	}
 
 	return apiResp.StatusCode(), apiResp.Body, err
@@ -208,11 +261,19 @@ func (ddr *productDefectdojoResource) readApiCall(ctx context.Context, client *d
 
 func (ddr *productDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
 	tflog.Info(ctx, "updateApiCall")
-	reqBody := dd.ProductsUpdateJSONRequestBody(ddr.Product)
-	apiResp, err := client.ProductsUpdateWithResponse(ctx, idNumber, reqBody)
+	reqBody, err := ddr.requestBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	apiResp, err := client.ProductsUpdateWithBodyWithResponse(ctx, idNumber, "application/json", reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
 	tflog.Info(ctx, fmt.Sprintf("response %s: %s", apiResp.Status(), apiResp.Body))
 	if apiResp.JSON200 != nil {
-		ddr.Product = *apiResp.JSON200
+		if err := ddr.setFromResponse(*apiResp.JSON200, apiResp.Body); err != nil {
+			return 0, nil, err
+		}
 	}
 	return apiResp.StatusCode(), apiResp.Body, err
 }
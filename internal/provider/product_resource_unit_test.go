@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -356,3 +357,58 @@ func TestProductResource__defectdojoResource_Nulls(t *testing.T) {
 	assert.DeepEqual(t, *ddProduct.Tags, []string{})
 	assert.DeepEqual(t, *ddProduct.Regulations, []int{})
 }
+
+func TestProductResourceSlaConfiguration(t *testing.T) {
+	productResource := productResourceData{
+		Name:               types.StringValue("A Name"),
+		ProductTypeId:      types.Int64Value(1),
+		SlaConfigurationId: types.Int64Value(3),
+		Tags:               types.SetNull(types.StringType),
+		RegulationIds:      types.SetNull(types.Int64Type),
+	}
+
+	ddResource := productResource.defectdojoResource()
+	ddProduct := ddResource.(*productDefectdojoResource)
+	var terraformResource terraformResourceData = &productResource
+	populateDefectdojoResource(context.Background(), &diag.Diagnostics{}, terraformResource, &ddResource)
+	assert.Equal(t, *ddProduct.SlaConfiguration, 3)
+
+	reqBody, err := ddProduct.requestBody()
+	assert.NilError(t, err)
+	var sent map[string]interface{}
+	assert.NilError(t, json.NewDecoder(reqBody).Decode(&sent))
+	assert.Equal(t, sent["sla_configuration"], float64(3))
+	assert.Equal(t, sent["name"], "A Name")
+
+	err = ddProduct.setFromResponse(dd.Product{Id: 99, Name: "A Name"}, []byte(`{"id": 99, "name": "A Name", "sla_configuration": 4}`))
+	assert.NilError(t, err)
+	populateResourceData(context.Background(), &diag.Diagnostics{}, &terraformResource, ddProduct)
+	assert.Equal(t, productResource.Id.ValueString(), "99")
+	assert.Equal(t, productResource.SlaConfigurationId.ValueInt64(), int64(4))
+}
+
+func TestProductResourceSlaConfigurationUnknown(t *testing.T) {
+	// an unconfigured SLA configuration is unknown on create, and left to the server
+	productResource := productResourceData{
+		Name:               types.StringValue("A Name"),
+		ProductTypeId:      types.Int64Value(1),
+		SlaConfigurationId: types.Int64Unknown(),
+		Tags:               types.SetNull(types.StringType),
+		RegulationIds:      types.SetNull(types.Int64Type),
+	}
+
+	ddResource := productResource.defectdojoResource()
+	ddProduct := ddResource.(*productDefectdojoResource)
+	var terraformResource terraformResourceData = &productResource
+	diags := diag.Diagnostics{}
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Assert(t, ddProduct.SlaConfiguration == nil)
+
+	reqBody, err := ddProduct.requestBody()
+	assert.NilError(t, err)
+	var sent map[string]interface{}
+	assert.NilError(t, json.NewDecoder(reqBody).Decode(&sent))
+	_, ok := sent["sla_configuration"]
+	assert.Equal(t, ok, false)
+}
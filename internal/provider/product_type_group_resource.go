@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t productTypeGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Product Type Group. Grants a Group a Role on a Product Type. It can be imported by its id, or by an id of the form `<product_type_id>:<group_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_type_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product Type",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Group",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role the Group has on the Product Type",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type productTypeGroupResourceData struct {
+	ProductTypeId types.Int64  `tfsdk:"product_type_id" ddField:"ProductType"`
+	GroupId       types.Int64  `tfsdk:"group_id" ddField:"Group"`
+	RoleId        types.Int64  `tfsdk:"role_id" ddField:"Role"`
+	Id            types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type productTypeGroupDefectdojoResource struct {
+	dd.ProductTypeGroup
+}
+
+func (ddr *productTypeGroupDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	return productTypeGroupsPath.create(ctx, client, &ddr.ProductTypeGroup)
+}
+
+func (ddr *productTypeGroupDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productTypeGroupsPath.read(ctx, client, idNumber, &ddr.ProductTypeGroup)
+}
+
+func (ddr *productTypeGroupDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productTypeGroupsPath.update(ctx, client, idNumber, &ddr.ProductTypeGroup)
+}
+
+func (ddr *productTypeGroupDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productTypeGroupsPath.delete(ctx, client, idNumber)
+}
+
+func lookupProductTypeGroup(ctx context.Context, client *dd.ClientWithResponses, productTypeId int, groupId int) (*int, error) {
+	apiResp, err := client.ProductTypeGroupsListWithResponse(ctx, &dd.ProductTypeGroupsListParams{ProductTypeId: &productTypeId, GroupId: &groupId})
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.JSON200 == nil {
+		return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+	}
+	if apiResp.JSON200.Results == nil || len(*apiResp.JSON200.Results) == 0 {
+		return nil, nil
+	}
+	return &(*apiResp.JSON200.Results)[0].Id, nil
+}
+
+type productTypeGroupResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &productTypeGroupResource{}
+var _ resource.ResourceWithImportState = &productTypeGroupResource{}
+
+func NewProductTypeGroupResource() resource.Resource {
+	return &productTypeGroupResource{
+		terraformResource: terraformResource{
+			dataProvider: productTypeGroupDataProvider{},
+		},
+	}
+}
+
+func (r productTypeGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_type_group"
+}
+
+func (r productTypeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importMembershipState(ctx, r.client, lookupProductTypeGroup, req, resp)
+}
+
+type productTypeGroupDataProvider struct{}
+
+func (r productTypeGroupDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data productTypeGroupResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *productTypeGroupResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *productTypeGroupResourceData) defectdojoResource() defectdojoResource {
+	return &productTypeGroupDefectdojoResource{
+		ProductTypeGroup: dd.ProductTypeGroup{},
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProductTypeGroupResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-product-type-group-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductTypeGroupResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_product_type_group.test", "product_type_id", "defectdojo_product_type.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_type_group.test", "group_id", "defectdojo_dojo_group.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_product_type_group.test", "role_id", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_type_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing with a composite id
+			{
+				ResourceName:      "defectdojo_product_type_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["defectdojo_product_type_group.test"].Primary.Attributes
+					return fmt.Sprintf("%s:%s", attributes["product_type_id"], attributes["group_id"]), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProductTypeGroupResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type_group.test", "role_id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProductTypeGroupResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductTypeGroupResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type_group.test", "role_id", "5"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccProductTypeGroupResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_product_type_group.test"),
+				),
+			},
+			{
+				Config: testAccProductTypeGroupResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type_group.test", "role_id", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProductTypeGroupResourceConfig(name string, roleId int) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product_type" "test" {
+  name = %[1]q
+}
+resource "defectdojo_dojo_group" "test" {
+  name = %[1]q
+}
+resource "defectdojo_product_type_group" "test" {
+  product_type_id = defectdojo_product_type.test.id
+  group_id = defectdojo_dojo_group.test.id
+  role_id = %[2]d
+}
+`, name, roleId)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t productTypeMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Product Type Member. Grants a User a Role on a Product Type. It can be imported by its id, or by an id of the form `<product_type_id>:<user_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"product_type_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product Type",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the User",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role the User has on the Product Type",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type productTypeMemberResourceData struct {
+	ProductTypeId types.Int64  `tfsdk:"product_type_id" ddField:"ProductType"`
+	UserId        types.Int64  `tfsdk:"user_id" ddField:"User"`
+	RoleId        types.Int64  `tfsdk:"role_id" ddField:"Role"`
+	Id            types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type productTypeMemberDefectdojoResource struct {
+	dd.ProductTypeMember
+}
+
+func (ddr *productTypeMemberDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	return productTypeMembersPath.create(ctx, client, &ddr.ProductTypeMember)
+}
+
+func (ddr *productTypeMemberDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productTypeMembersPath.read(ctx, client, idNumber, &ddr.ProductTypeMember)
+}
+
+func (ddr *productTypeMemberDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productTypeMembersPath.update(ctx, client, idNumber, &ddr.ProductTypeMember)
+}
+
+func (ddr *productTypeMemberDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return productTypeMembersPath.delete(ctx, client, idNumber)
+}
+
+func lookupProductTypeMember(ctx context.Context, client *dd.ClientWithResponses, productTypeId int, userId int) (*int, error) {
+	apiResp, err := client.ProductTypeMembersListWithResponse(ctx, &dd.ProductTypeMembersListParams{ProductTypeId: &productTypeId, UserId: &userId})
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.JSON200 == nil {
+		return nil, unexpectedMembershipResponse(apiResp.StatusCode(), apiResp.Body)
+	}
+	if apiResp.JSON200.Results == nil || len(*apiResp.JSON200.Results) == 0 {
+		return nil, nil
+	}
+	return &(*apiResp.JSON200.Results)[0].Id, nil
+}
+
+type productTypeMemberResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &productTypeMemberResource{}
+var _ resource.ResourceWithImportState = &productTypeMemberResource{}
+
+func NewProductTypeMemberResource() resource.Resource {
+	return &productTypeMemberResource{
+		terraformResource: terraformResource{
+			dataProvider: productTypeMemberDataProvider{},
+		},
+	}
+}
+
+func (r productTypeMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_type_member"
+}
+
+func (r productTypeMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importMembershipState(ctx, r.client, lookupProductTypeMember, req, resp)
+}
+
+type productTypeMemberDataProvider struct{}
+
+func (r productTypeMemberDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data productTypeMemberResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *productTypeMemberResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *productTypeMemberResourceData) defectdojoResource() defectdojoResource {
+	return &productTypeMemberDefectdojoResource{
+		ProductTypeMember: dd.ProductTypeMember{},
+	}
+}
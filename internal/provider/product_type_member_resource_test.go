@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProductTypeMemberResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-product-type-member-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductTypeMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_product_type_member.test", "product_type_id", "defectdojo_product_type.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_product_type_member.test", "user_id", "defectdojo_user.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_product_type_member.test", "role_id", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_product_type_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing with a composite id
+			{
+				ResourceName:      "defectdojo_product_type_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					attributes := s.RootModule().Resources["defectdojo_product_type_member.test"].Primary.Attributes
+					return fmt.Sprintf("%s:%s", attributes["product_type_id"], attributes["user_id"]), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProductTypeMemberResourceConfig(name, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type_member.test", "role_id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProductTypeMemberResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductTypeMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type_member.test", "role_id", "5"),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccProductTypeMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_product_type_member.test"),
+				),
+			},
+			{
+				Config: testAccProductTypeMemberResourceConfig(name, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type_member.test", "role_id", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProductTypeMemberResourceConfig(name string, roleId int) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product_type" "test" {
+  name = %[1]q
+}
+resource "defectdojo_user" "test" {
+  username = %[1]q
+  email = "%[1]s@example.com"
+}
+resource "defectdojo_product_type_member" "test" {
+  product_type_id = defectdojo_product_type.test.id
+  user_id = defectdojo_user.test.id
+  role_id = %[2]d
+}
+`, name, roleId)
+}
@@ -25,10 +25,6 @@ func (t productTypeResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the Product Type",
 				Optional:            true,
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringDefault(""),
-				},
 			},
 			"critical_product": schema.BoolAttribute{
 				MarkdownDescription: "Is this a critical Product Type",
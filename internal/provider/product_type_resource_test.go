@@ -51,6 +51,50 @@ func TestAccProductTypeResource(t *testing.T) {
 	})
 }
 
+func TestAccProductTypeResourceWithoutDescription(t *testing.T) {
+	name := fmt.Sprintf("dox-test-pt-%s", resource.UniqueId())
+	desc := fmt.Sprintf("dox test pt description %s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProductTypeResourceMinimalConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type.test", "name", name),
+					resource.TestCheckNoResourceAttr("defectdojo_product_type.test", "description"),
+					resource.TestCheckResourceAttr("defectdojo_product_type.test", "critical_product", "false"),
+					resource.TestCheckResourceAttr("defectdojo_product_type.test", "key_product", "false"),
+				),
+			},
+			{
+				Config: testAccProductTypeResourceConfig(name, desc, "false", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_product_type.test", "description", desc),
+				),
+			},
+			// Removing the description clears it
+			{
+				Config: testAccProductTypeResourceMinimalConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("defectdojo_product_type.test", "description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProductTypeResourceMinimalConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product_type" "test" {
+  name = %[1]q
+}
+`, name)
+}
+
 func testAccProductTypeResourceConfig(name string, desc string, criticalProduct string, keyProduct string) string {
 	return fmt.Sprintf(`
 provider "defectdojo" {}
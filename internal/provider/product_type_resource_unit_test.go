@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestProductTypeResourceOmittedDescription(t *testing.T) {
+	schemaResp := resource.SchemaResponse{}
+	productTypeResource{}.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	// an omitted description is planned as null rather than computed by the server
+	assert.Equal(t, schemaResp.Schema.Attributes["description"].IsComputed(), false)
+
+	productTypeResource := productTypeResourceData{
+		Name:            types.StringValue("A Product Type"),
+		Description:     types.StringNull(),
+		CriticalProduct: types.BoolNull(),
+		KeyProduct:      types.BoolNull(),
+	}
+	var terraformResource terraformResourceData = &productTypeResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddProductType := ddResource.(*productTypeDefectdojoResource)
+	body, err := json.Marshal(dd.ProductTypesCreateJSONRequestBody(ddProductType.ProductType))
+	assert.NilError(t, err)
+	var sent map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	value, ok := sent["description"]
+	assert.Assert(t, ok && value == nil)
+
+	// the null description returned by the API is kept as null
+	ddProductType.ProductType = dd.ProductType{Id: 3, Name: "A Product Type"}
+	populateResourceData(context.Background(), &diags, &terraformResource, ddProductType)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, productTypeResource.Id.ValueString(), "3")
+	assert.Equal(t, productTypeResource.Description.IsNull(), true)
+}
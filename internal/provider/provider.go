@@ -168,6 +168,32 @@ func (p *DefectDojoProvider) Resources(ctx context.Context) []func() resource.Re
 		NewProductResource,
 		NewProductTypeResource,
 		NewJiraProductConfigurationResource,
+		NewEngagementResource,
+		NewTestResource,
+		NewScanImportResource,
+		NewScanReimportResource,
+		NewUserResource,
+		NewDojoGroupResource,
+		NewDojoGroupMemberResource,
+		NewProductMemberResource,
+		NewProductGroupResource,
+		NewProductTypeMemberResource,
+		NewProductTypeGroupResource,
+		NewProductMembersResource,
+		NewJiraInstanceResource,
+		NewJiraFindingMappingResource,
+		NewSlaConfigurationResource,
+		NewRegulationResource,
+		NewDevelopmentEnvironmentResource,
+		NewTestTypeResource,
+		NewToolTypeResource,
+		NewToolConfigurationResource,
+		NewToolProductSettingsResource,
+		NewProductApiScanConfigurationResource,
+		NewFindingResource,
+		NewFindingTriageResource,
+		NewFindingBulkTriageResource,
+		NewRiskAcceptanceResource,
 	}
 }
 
@@ -175,6 +201,15 @@ func (p *DefectDojoProvider) DataSources(ctx context.Context) []func() datasourc
 	return []func() datasource.DataSource{
 		NewProductDataSource,
 		NewProductTypeDataSource,
+		NewUserDataSource,
+		NewRoleDataSource,
+		NewJiraInstanceDataSource,
+		NewJiraProductConfigurationDataSource,
+		NewSlaConfigurationDataSource,
+		NewRegulationDataSource,
+		NewDevelopmentEnvironmentDataSource,
+		NewTestTypeDataSource,
+		NewFindingsDataSource,
 	}
 
 }
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	dd "github.com/doximity/defect-dojo-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"gotest.tools/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -24,3 +29,29 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// unreachableClient returns a client for a server that is already closed, so that every request
+// fails with a transport error.
+func unreachableClient(t *testing.T) *dd.ClientWithResponses {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+	return client
+}
+
+// assertTransportErrors makes sure that the API calls of a resource return an error, rather than
+// panicking on the missing response, when DefectDojo can't be reached.
+func assertTransportErrors(t *testing.T, ddr defectdojoResource) {
+	ctx := context.Background()
+	client := unreachableClient(t)
+
+	_, _, err := ddr.createApiCall(ctx, client)
+	assert.Assert(t, err != nil, "create")
+	_, _, err = ddr.readApiCall(ctx, client, 1)
+	assert.Assert(t, err != nil, "read")
+	_, _, err = ddr.updateApiCall(ctx, client, 1)
+	assert.Assert(t, err != nil, "update")
+	_, _, err = ddr.deleteApiCall(ctx, client, 1)
+	assert.Assert(t, err != nil, "delete")
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+)
+
+// rawApiCall sends a JSON request to an endpoint the client doesn't know
+// about, going through the same http client and request editors (and so the
+// same authentication) as the generated calls. The operationPath is relative
+// to the server, for example `api/v2/sla_configurations/`. When result is not
+// nil and the response is successful, the response body is decoded into it.
+func rawApiCall(ctx context.Context, client *dd.ClientWithResponses, method string, operationPath string, query url.Values, body interface{}, result interface{}) (int, []byte, error) {
+	var reqBody io.Reader
+	contentType := ""
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, nil, err
+		}
+		reqBody = bytes.NewReader(encoded)
+		contentType = "application/json"
+	}
+
+	return rawApiRequest(ctx, client, method, operationPath, query, contentType, reqBody, result)
+}
+
+// rawMultipartApiCall is like rawApiCall, but sends the given form fields and
+// the contents of the file at filePath, under fileField, as a
+// multipart/form-data request. This is how files are uploaded to endpoints
+// the client doesn't know about.
+func rawMultipartApiCall(ctx context.Context, client *dd.ClientWithResponses, method string, operationPath string, fields url.Values, fileField string, filePath string, result interface{}) (int, []byte, error) {
+	reqBody, contentType, err := newMultipartFileBody(fields, fileField, filePath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return rawApiRequest(ctx, client, method, operationPath, nil, contentType, reqBody, result)
+}
+
+func rawApiRequest(ctx context.Context, client *dd.ClientWithResponses, method string, operationPath string, query url.Values, contentType string, reqBody io.Reader, result interface{}) (int, []byte, error) {
+	c, ok := client.ClientInterface.(*dd.Client)
+	if !ok {
+		return 0, nil, fmt.Errorf("Expected the client to be a dd.Client, got: %T. Please report this issue to the provider developers.", client.ClientInterface)
+	}
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return 0, nil, err
+	}
+	queryURL, err := serverURL.Parse("./" + operationPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	if query != nil {
+		queryURL.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, queryURL.String(), reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if contentType != "" {
+		req.Header.Add("Content-Type", contentType)
+	}
+	for _, editor := range c.RequestEditors {
+		if err := editor(ctx, req); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer rsp.Body.Close()
+
+	respBody, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if result != nil && rsp.StatusCode >= 200 && rsp.StatusCode < 300 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return rsp.StatusCode, respBody, nil
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"gotest.tools/assert"
+)
+
+func TestRawApiCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Header.Get("Authorization"), "Token secret")
+		assert.Equal(t, r.URL.Path, "/api/v2/sla_configurations/")
+		assert.Equal(t, r.URL.Query().Get("limit"), "100")
+		assert.Equal(t, r.Header.Get("Content-Type"), "application/json")
+
+		body, err := io.ReadAll(r.Body)
+		assert.NilError(t, err)
+		var sla slaConfiguration
+		assert.NilError(t, json.Unmarshal(body, &sla))
+		assert.Equal(t, sla.Name, "Internet facing")
+
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": 3, "name": "Internet facing", "critical": 7}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL+"/", dd.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Add("Authorization", "Token secret")
+		return nil
+	}))
+	assert.NilError(t, err)
+
+	var created slaConfiguration
+	statusCode, body, err := rawApiCall(context.Background(), client, http.MethodPost, slaConfigurationsPath, url.Values{"limit": []string{"100"}}, slaConfiguration{Name: "Internet facing"}, &created)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+	assert.Assert(t, len(body) > 0)
+	assert.Equal(t, created.Id, 3)
+	assert.Equal(t, created.Critical, 7)
+}
+
+func TestRawApiCallError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		_, _ = w.Write([]byte(`{"name": ["This field is required."]}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	// the body of an unsuccessful response is returned but not decoded
+	var created slaConfiguration
+	statusCode, body, err := rawApiCall(context.Background(), client, http.MethodPost, slaConfigurationsPath, nil, slaConfiguration{}, &created)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 400)
+	assert.Equal(t, string(body), `{"name": ["This field is required."]}`)
+	assert.Equal(t, created.Id, 0)
+}
+
+func TestRawMultipartApiCall(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "proof.txt")
+	assert.NilError(t, os.WriteFile(filePath, []byte("Signed off"), 0600))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Header.Get("Authorization"), "Token secret")
+		assert.Equal(t, r.Method, http.MethodPatch)
+		assert.Equal(t, r.URL.Path, "/api/v2/risk_acceptance/3/")
+
+		assert.NilError(t, r.ParseMultipartForm(1<<20))
+		assert.DeepEqual(t, r.MultipartForm.Value["name"], []string{"Accepted"})
+		f, header, err := r.FormFile("path")
+		assert.NilError(t, err)
+		assert.Equal(t, header.Filename, "proof.txt")
+		contents, err := io.ReadAll(f)
+		assert.NilError(t, err)
+		assert.Equal(t, string(contents), "Signed off")
+
+		_, _ = w.Write([]byte(`{"id": 3, "name": "Accepted"}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL+"/", dd.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Add("Authorization", "Token secret")
+		return nil
+	}))
+	assert.NilError(t, err)
+
+	var updated riskAcceptance
+	statusCode, _, err := rawMultipartApiCall(context.Background(), client, http.MethodPatch, riskAcceptancesPath+"3/", url.Values{"name": []string{"Accepted"}}, "path", filePath, &updated)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 200)
+	assert.Equal(t, updated.Id, 3)
+}
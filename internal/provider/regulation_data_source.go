@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func (t regulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo Regulation, looked up by its `acronym`. The `id` can be used in the `regulation_ids` of a `defectdojo_product`.",
+
+		Attributes: map[string]schema.Attribute{
+			"acronym": schema.StringAttribute{
+				MarkdownDescription: "The acronym of the Regulation, for example `HIPAA`",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Regulation",
+				Computed:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "The subject of the Regulation",
+				Computed:            true,
+			},
+			"jurisdiction": schema.StringAttribute{
+				MarkdownDescription: "The territory over which the Regulation applies",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Information about the purpose of the Regulation",
+				Computed:            true,
+			},
+			"reference": schema.StringAttribute{
+				MarkdownDescription: "An external URL for more information",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type regulationDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d regulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regulation"
+}
+
+func NewRegulationDataSource() datasource.DataSource {
+	return &regulationDataSource{}
+}
+
+func (r *regulationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d regulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// the data source exposes the same attributes as the resource
+	var data regulationResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the API can't filter on the acronym, so we go through all the pages and
+	// match it ourselves
+	matches := []dd.Regulation{}
+	for offset := 0; ; offset += listPageSize {
+		apiResp, err := d.client.RegulationsListWithResponse(ctx, &dd.RegulationsListParams{
+			Limit:  ref.Of(listPageSize),
+			Offset: ref.Of(offset),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Retrieving Resource",
+				fmt.Sprintf("%s", err))
+			return
+		}
+
+		if apiResp.StatusCode() != 200 {
+			resp.Diagnostics.AddError(
+				"API Error Retrieving Data Source",
+				fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+					fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+			)
+			return
+		}
+
+		if apiResp.JSON200.Results != nil {
+			for _, regulation := range *apiResp.JSON200.Results {
+				if regulation.Acronym == data.Acronym.ValueString() {
+					matches = append(matches, regulation)
+				}
+			}
+		}
+
+		if apiResp.JSON200.Next == nil {
+			break
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			"No Regulations matched the given parameters.")
+		return
+	} else if len(matches) > 1 {
+		var names string
+		for _, regulation := range matches {
+			names += fmt.Sprintf("\n  - %d: %s", regulation.Id, regulation.Name)
+		}
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			fmt.Sprintf("%d Regulations matched the given parameters:\n%s", len(matches), names))
+		return
+	}
+
+	var terraformResource terraformResourceData = &data
+	populateResourceData(ctx, &resp.Diagnostics, &terraformResource, &regulationDefectdojoResource{
+		Regulation: matches[0],
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRegulationDataSource(t *testing.T) {
+	acronym := fmt.Sprintf("DOX%s", resource.UniqueId()[20:])
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRegulationDataSourceConfig(acronym),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_regulation.test", "id", "defectdojo_regulation.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_regulation.test", "name", "Doximity Test Regulation"),
+					resource.TestCheckResourceAttr("data.defectdojo_regulation.test", "category", "finance"),
+					resource.TestCheckResourceAttr("data.defectdojo_regulation.test", "jurisdiction", "Europe"),
+					resource.TestCheckResourceAttr("data.defectdojo_regulation.test", "reference", "https://example.com/regulation"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRegulationDataSourceNoMatch(t *testing.T) {
+	acronym := fmt.Sprintf("DOX%s", resource.UniqueId()[20:])
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Regulations matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_regulation" "test" {
+  acronym = %q
+}
+`, acronym),
+			},
+		},
+	})
+}
+
+func testAccRegulationDataSourceConfig(acronym string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_regulation" "test" {
+  name = "Doximity Test Regulation"
+  acronym = %q
+  category = "finance"
+  jurisdiction = "Europe"
+  reference = "https://example.com/regulation"
+}
+data "defectdojo_regulation" "test" {
+  acronym = defectdojo_regulation.test.acronym
+}
+`, acronym)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t regulationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Regulation, such as HIPAA or PCI DSS. Its `id` can be used in the `regulation_ids` of a `defectdojo_product`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Regulation",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			"acronym": schema.StringAttribute{
+				MarkdownDescription: "A shortened representation of the name",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(20),
+				},
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "The subject of the Regulation. Valid values are: 'privacy', 'finance', 'education', 'medical', 'corporate', 'other'",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("privacy", "finance", "education", "medical", "corporate", "other"),
+				},
+			},
+			"jurisdiction": schema.StringAttribute{
+				MarkdownDescription: "The territory over which the Regulation applies",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Information about the purpose of the Regulation",
+				Optional:            true,
+			},
+			"reference": schema.StringAttribute{
+				MarkdownDescription: "An external URL for more information",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type regulationResourceData struct {
+	Name         types.String `tfsdk:"name" ddField:"Name"`
+	Acronym      types.String `tfsdk:"acronym" ddField:"Acronym"`
+	Category     types.String `tfsdk:"category" ddField:"Category"`
+	Jurisdiction types.String `tfsdk:"jurisdiction" ddField:"Jurisdiction"`
+	Description  types.String `tfsdk:"description" ddField:"Description"`
+	Reference    types.String `tfsdk:"reference" ddField:"Reference"`
+	Id           types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type regulationDefectdojoResource struct {
+	dd.Regulation
+}
+
+func (ddr *regulationDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.RegulationsCreateJSONRequestBody(ddr.Regulation)
+	apiResp, err := client.RegulationsCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.Regulation = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *regulationDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.RegulationsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.Regulation = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *regulationDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.RegulationsUpdateJSONRequestBody(ddr.Regulation)
+	apiResp, err := client.RegulationsUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.Regulation = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *regulationDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.RegulationsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type regulationResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &regulationResource{}
+var _ resource.ResourceWithImportState = &regulationResource{}
+
+func NewRegulationResource() resource.Resource {
+	return &regulationResource{
+		terraformResource: terraformResource{
+			dataProvider: regulationDataProvider{},
+		},
+	}
+}
+
+func (r regulationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regulation"
+}
+
+type regulationDataProvider struct{}
+
+func (r regulationDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data regulationResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *regulationResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *regulationResourceData) defectdojoResource() defectdojoResource {
+	return &regulationDefectdojoResource{
+		Regulation: dd.Regulation{},
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRegulationResource(t *testing.T) {
+	// acronyms are limited to 20 characters
+	acronym := fmt.Sprintf("DOX%s", resource.UniqueId()[20:])
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRegulationResourceConfig(acronym),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "name", "Doximity Test Regulation"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "acronym", acronym),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "category", "privacy"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "jurisdiction", "United States"),
+					resource.TestCheckNoResourceAttr("defectdojo_regulation.test", "description"),
+					resource.TestCheckNoResourceAttr("defectdojo_regulation.test", "reference"),
+					resource.TestCheckTypeSetElemAttrPair("defectdojo_product.test", "regulation_ids.*", "defectdojo_regulation.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_regulation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRegulationResourceUpdateConfig(acronym),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "category", "medical"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "description", "A regulation for testing"),
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "reference", "https://example.com/regulation"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRegulationResourceDeleteDrift(t *testing.T) {
+	acronym := fmt.Sprintf("DEL%s", resource.UniqueId()[20:])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRegulationResourceConfig(acronym),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_regulation.test", "acronym", acronym),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccRegulationResourceConfig(acronym),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_regulation.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegulationResourceConfig(acronym string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_regulation" "test" {
+  name = "Doximity Test Regulation"
+  acronym = %[1]q
+  category = "privacy"
+  jurisdiction = "United States"
+}
+resource "defectdojo_product" "test" {
+  name = "dox-test-regulation-%[1]s"
+  description = "test"
+  product_type_id = 1
+  regulation_ids = [defectdojo_regulation.test.id]
+}
+`, acronym)
+}
+
+func testAccRegulationResourceUpdateConfig(acronym string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_regulation" "test" {
+  name = "Doximity Test Regulation"
+  acronym = %[1]q
+  category = "medical"
+  jurisdiction = "United States"
+  description = "A regulation for testing"
+  reference = "https://example.com/regulation"
+}
+resource "defectdojo_product" "test" {
+  name = "dox-test-regulation-%[1]s"
+  description = "test"
+  product_type_id = 1
+  regulation_ids = [defectdojo_regulation.test.id]
+}
+`, acronym)
+}
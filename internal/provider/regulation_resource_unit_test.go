@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestRegulationResource__defectdojoResource(t *testing.T) {
+	regulationResource := regulationResourceData{
+		Name:         types.StringValue("Health Insurance Portability and Accountability Act"),
+		Acronym:      types.StringValue("HIPAA"),
+		Category:     types.StringValue("medical"),
+		Jurisdiction: types.StringValue("United States"),
+		Description:  types.StringNull(),
+		Reference:    types.StringValue("https://www.hhs.gov/hipaa"),
+	}
+	var terraformResource terraformResourceData = &regulationResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddRegulation := ddResource.(*regulationDefectdojoResource)
+	assert.Equal(t, ddRegulation.Name, "Health Insurance Portability and Accountability Act")
+	assert.Equal(t, ddRegulation.Acronym, "HIPAA")
+	assert.Equal(t, ddRegulation.Category, dd.RegulationCategoryMedical)
+	assert.Equal(t, ddRegulation.Jurisdiction, "United States")
+	assert.Assert(t, ddRegulation.Description == nil)
+	assert.Equal(t, *ddRegulation.Reference, "https://www.hhs.gov/hipaa")
+}
+
+func TestRegulationResourcePopulate(t *testing.T) {
+	ddRegulation := regulationDefectdojoResource{
+		Regulation: dd.Regulation{
+			Id:           42,
+			Name:         "Payment Card Industry Data Security Standard",
+			Acronym:      "PCI DSS",
+			Category:     dd.RegulationCategoryFinance,
+			Jurisdiction: "Worldwide",
+			Description:  ref.Of("Security standard for organizations that handle card payments"),
+		},
+	}
+
+	regulationResource := regulationResourceData{}
+	var terraformResource terraformResourceData = &regulationResource
+
+	diags := diag.Diagnostics{}
+	populateResourceData(context.Background(), &diags, &terraformResource, &ddRegulation)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, regulationResource.Id.ValueString(), "42")
+	assert.Equal(t, regulationResource.Acronym.ValueString(), "PCI DSS")
+	assert.Equal(t, regulationResource.Category.ValueString(), "finance")
+	assert.Equal(t, regulationResource.Jurisdiction.ValueString(), "Worldwide")
+	assert.Equal(t, regulationResource.Description.ValueString(), "Security standard for organizations that handle card payments")
+	assert.Equal(t, regulationResource.Reference.IsNull(), true)
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	dd "github.com/doximity/defect-dojo-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,6 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// the page size used when going through every page of a list endpoint
+const listPageSize = 100
+
 type terraformResourceData interface {
 	id() types.String
 	defectdojoResource() defectdojoResource
@@ -42,9 +47,13 @@ type dataGetter interface {
 var typeOfTypesString = reflect.TypeOf(types.String{})
 var typeOfTypesBool = reflect.TypeOf(types.Bool{})
 var typeOfTypesInt64 = reflect.TypeOf(types.Int64{})
+var typeOfTypesFloat64 = reflect.TypeOf(types.Float64{})
 var typeOfStringSlice = reflect.TypeOf([]string{})
 var typeOfInt64Slice = reflect.TypeOf([]int64{})
 var typeOfTypesSet = reflect.TypeOf(types.Set{})
+var typeOfDate = reflect.TypeOf(openapi_types.Date{})
+var typeOfTime = reflect.TypeOf(time.Time{})
+var typeOfTypesObject = reflect.TypeOf(types.Object{})
 
 func (r *terraformResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -310,6 +319,15 @@ func populateDefectdojoResource(ctx context.Context, diags *diag.Diagnostics, re
 			// fmt.Printf("ddFieldDescriptor: Kind = %s, Name = %s\n", ddFieldDescriptor.Type.Kind(), ddFieldDescriptor.Name)
 			// fmt.Printf("fieldDescriptor: Kind = %s, Name = %s, type = %s\n", fieldDescriptor.Type.Kind(), fieldDescriptor.Name, fieldDescriptor.Type)
 
+			switch fieldDescriptor.Type {
+			case typeOfTypesString, typeOfTypesBool, typeOfTypesInt64, typeOfTypesFloat64:
+				// an unknown value is one the API computes, so it's left out of the request rather than
+				// sent as the zero value of its type
+				if fieldValue.MethodByName("IsUnknown").Call(nil)[0].Bool() {
+					continue
+				}
+			}
+
 			switch fieldDescriptor.Type {
 
 			case typeOfTypesString:
@@ -317,7 +335,7 @@ func populateDefectdojoResource(ctx context.Context, diags *diag.Diagnostics, re
 					// if the destination field is a string, we can grab the `Value` field and assign it directly
 					srcIsNull := fieldValue.MethodByName("IsNull").Call(nil)[0].Bool()
 					if !srcIsNull {
-						ddFieldValue.Set(fieldValue.MethodByName("ValueString").Call(nil)[0])
+						ddFieldValue.Set(fieldValue.MethodByName("ValueString").Call(nil)[0].Convert(ddFieldDescriptor.Type))
 					}
 				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem().Kind() == reflect.String {
 					// the destination field is a *string (or compatible/alias) so we have to set it to a pointer
@@ -361,6 +379,42 @@ func populateDefectdojoResource(ctx context.Context, diags *diag.Diagnostics, re
 						destVal.Elem().Set(reflect.ValueOf(num))
 						ddFieldValue.Set(destVal)
 					}
+				} else if ddFieldDescriptor.Type == typeOfDate || (ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem() == typeOfDate) {
+					// the destination field is a date (or *date), which the API expects in YYYY-MM-DD format
+					srcIsNull := fieldValue.MethodByName("IsNull").Call(nil)[0].Bool()
+					if !srcIsNull {
+						str := fieldValue.MethodByName("ValueString").Call(nil)[0].String()
+						t, err := time.Parse(openapi_types.DateFormat, str)
+						if err != nil {
+							diags.AddError("Error converting value", fmt.Sprintf("Could not convert string value %s to a date: %s", str, err))
+							continue
+						}
+						destVal := reflect.ValueOf(openapi_types.Date{Time: t})
+						if ddFieldDescriptor.Type.Kind() == reflect.Ptr {
+							ptr := reflect.New(typeOfDate)
+							ptr.Elem().Set(destVal)
+							destVal = ptr
+						}
+						ddFieldValue.Set(destVal)
+					}
+				} else if ddFieldDescriptor.Type == typeOfTime || (ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem() == typeOfTime) {
+					// the destination field is a timestamp (or *timestamp), which we keep in RFC3339 format
+					srcIsNull := fieldValue.MethodByName("IsNull").Call(nil)[0].Bool()
+					if !srcIsNull {
+						str := fieldValue.MethodByName("ValueString").Call(nil)[0].String()
+						t, err := time.Parse(time.RFC3339, str)
+						if err != nil {
+							diags.AddError("Error converting value", fmt.Sprintf("Could not convert string value %s to a timestamp: %s", str, err))
+							continue
+						}
+						destVal := reflect.ValueOf(t)
+						if ddFieldDescriptor.Type.Kind() == reflect.Ptr {
+							ptr := reflect.New(typeOfTime)
+							ptr.Elem().Set(destVal)
+							destVal = ptr
+						}
+						ddFieldValue.Set(destVal)
+					}
 				} else {
 					tflog.Warn(ctx, fmt.Sprintf("WARN [populateDefectdojoResource]: Don't know how to assign type %s to type %s\n", fieldDescriptor.Type, ddFieldDescriptor.Type))
 				}
@@ -404,8 +458,31 @@ func populateDefectdojoResource(ctx context.Context, diags *diag.Diagnostics, re
 					tflog.Warn(ctx, fmt.Sprintf("WARN [populateDefectdojoResource]: Don't know how to assign type %s to type %s\n", fieldDescriptor.Type, ddFieldDescriptor.Type))
 				}
 
+			case typeOfTypesFloat64:
+				if ddFieldDescriptor.Type.Kind() == reflect.Float64 || ddFieldDescriptor.Type.Kind() == reflect.Float32 {
+					// if the destination field is a float, we can grab the `Value` field and cast and assign it directly
+					ddFieldValue.Set(fieldValue.MethodByName("ValueFloat64").Call(nil)[0].Convert(ddFieldDescriptor.Type))
+				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && (ddFieldDescriptor.Type.Elem().Kind() == reflect.Float64 || ddFieldDescriptor.Type.Elem().Kind() == reflect.Float32) {
+					// the destination field is a *float so we have to set it to a pointer
+					srcIsNull := fieldValue.MethodByName("IsNull").Call(nil)[0].Bool()
+					if !srcIsNull {
+						destType := ddFieldDescriptor.Type.Elem()
+						destVal := reflect.New(destType)
+						destVal.Elem().Set(fieldValue.MethodByName("ValueFloat64").Call(nil)[0].Convert(destType))
+						ddFieldValue.Set(destVal)
+					} else {
+						ddFieldValue.Set(reflect.New(ddFieldDescriptor.Type).Elem())
+					}
+				} else {
+					tflog.Warn(ctx, fmt.Sprintf("WARN [populateDefectdojoResource]: Don't know how to assign type %s to type %s\n", fieldDescriptor.Type, ddFieldDescriptor.Type))
+				}
+
 			case typeOfTypesSet:
-				if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem().Kind() == reflect.Slice {
+				if ddFieldDescriptor.Type == typeOfTypesSet {
+					// sets of nested objects have no counterpart in the client types, so the defectdojo
+					// resource keeps the terraform value and converts it itself
+					ddFieldValue.Set(fieldValue)
+				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem().Kind() == reflect.Slice {
 					// the source field is a pointer to a slice
 					if ddFieldDescriptor.Type.Elem().Elem().Kind() == reflect.Int {
 						// it's a slice of int
@@ -460,6 +537,15 @@ func populateDefectdojoResource(ctx context.Context, diags *diag.Diagnostics, re
 					tflog.Warn(ctx, fmt.Sprintf("WARN [populateDefectdojoResource]: Don't know how to assign type %s to type %s\n", fieldDescriptor.Type, ddFieldDescriptor.Type))
 				}
 
+			case typeOfTypesObject:
+				if ddFieldDescriptor.Type == typeOfTypesObject {
+					// nested objects have no counterpart in the client types, so the defectdojo resource
+					// keeps the terraform value and converts it itself
+					ddFieldValue.Set(fieldValue)
+				} else {
+					tflog.Warn(ctx, fmt.Sprintf("WARN [populateDefectdojoResource]: Don't know how to assign type %s to type %s\n", fieldDescriptor.Type, ddFieldDescriptor.Type))
+				}
+
 			default:
 				tflog.Warn(ctx, fmt.Sprintf("WARN [populateDefectdojoResource]: Don't know how to assign anything (type was %s) to type %s\n", fieldDescriptor.Type, ddFieldDescriptor.Type))
 			}
@@ -517,6 +603,22 @@ func populateResourceData(ctx context.Context, diags *diag.Diagnostics, d *terra
 					} else {
 						fieldValue.Set(reflect.ValueOf(types.StringNull()))
 					}
+				} else if ddFieldDescriptor.Type == typeOfDate {
+					fieldValue.Set(reflect.ValueOf(types.StringValue(ddFieldValue.Interface().(openapi_types.Date).String())))
+				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem() == typeOfDate {
+					if !ddFieldValue.IsNil() {
+						fieldValue.Set(reflect.ValueOf(types.StringValue(ddFieldValue.Elem().Interface().(openapi_types.Date).String())))
+					} else {
+						fieldValue.Set(reflect.ValueOf(types.StringNull()))
+					}
+				} else if ddFieldDescriptor.Type == typeOfTime {
+					fieldValue.Set(reflect.ValueOf(types.StringValue(ddFieldValue.Interface().(time.Time).Format(time.RFC3339))))
+				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem() == typeOfTime {
+					if !ddFieldValue.IsNil() {
+						fieldValue.Set(reflect.ValueOf(types.StringValue(ddFieldValue.Elem().Interface().(time.Time).Format(time.RFC3339))))
+					} else {
+						fieldValue.Set(reflect.ValueOf(types.StringNull()))
+					}
 				} else {
 					tflog.Warn(ctx, fmt.Sprintf("WARN [populateResourceData]: Don't know how to assign type %s to type %s\n", ddFieldDescriptor.Type, fieldDescriptor.Type))
 				}
@@ -553,8 +655,25 @@ func populateResourceData(ctx context.Context, diags *diag.Diagnostics, d *terra
 					tflog.Warn(ctx, fmt.Sprintf("WARN [populateResourceData]: Don't know how to assign type %s to type %s\n", ddFieldDescriptor.Type, fieldDescriptor.Type))
 				}
 
+			case typeOfTypesFloat64:
+				if ddFieldDescriptor.Type.Kind() == reflect.Float64 || ddFieldDescriptor.Type.Kind() == reflect.Float32 {
+					fieldValue.Set(reflect.ValueOf(types.Float64Value(ddFieldValue.Float())))
+				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && (ddFieldDescriptor.Type.Elem().Kind() == reflect.Float64 || ddFieldDescriptor.Type.Elem().Kind() == reflect.Float32) {
+					// if the source field is a pointer, make sure it's a pointer to a float, and then we can grab the pointed-to value,
+					// but only if the pointer is not nil
+					if !ddFieldValue.IsNil() {
+						fieldValue.Set(reflect.ValueOf(types.Float64Value(ddFieldValue.Elem().Float())))
+					} else {
+						fieldValue.Set(reflect.ValueOf(types.Float64Null()))
+					}
+				} else {
+					tflog.Warn(ctx, fmt.Sprintf("WARN [populateResourceData]: Don't know how to assign type %s to type %s\n", ddFieldDescriptor.Type, fieldDescriptor.Type))
+				}
+
 			case typeOfTypesSet:
-				if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem().Kind() == reflect.Slice {
+				if ddFieldDescriptor.Type == typeOfTypesSet {
+					fieldValue.Set(ddFieldValue)
+				} else if ddFieldDescriptor.Type.Kind() == reflect.Ptr && ddFieldDescriptor.Type.Elem().Kind() == reflect.Slice {
 					// the source field is a pointer to a slice
 					if ddFieldDescriptor.Type.Elem().Elem().Kind() == reflect.Int {
 						// it's a slice of int
@@ -590,6 +709,12 @@ func populateResourceData(ctx context.Context, diags *diag.Diagnostics, d *terra
 				} else {
 					tflog.Warn(ctx, fmt.Sprintf("WARN [populateResourceData]: Don't know how to assign type %s to type %s\n", ddFieldDescriptor.Type, fieldDescriptor.Type))
 				}
+			case typeOfTypesObject:
+				if ddFieldDescriptor.Type == typeOfTypesObject {
+					fieldValue.Set(ddFieldValue)
+				} else {
+					tflog.Warn(ctx, fmt.Sprintf("WARN [populateResourceData]: Don't know how to assign type %s to type %s\n", ddFieldDescriptor.Type, fieldDescriptor.Type))
+				}
 			default:
 				tflog.Warn(ctx, fmt.Sprintf("WARN [populateResourceData]: Don't know how to assign anything (type was %s) to type %s\n", ddFieldDescriptor.Type, fieldDescriptor.Type))
 			}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t riskAcceptanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Risk Acceptance marks a set of Findings as accepted risks until it expires. The Findings all have to belong to the same Engagement. Destroying this resource removes the Risk Acceptance, which reactivates its Findings.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Risk Acceptance",
+				Required:            true,
+			},
+			"accepted_finding_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the Findings whose risk is accepted",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"owner_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the User owning the Risk Acceptance. Only the owner and staff users can edit it.",
+				Required:            true,
+			},
+			"accepted_by": schema.StringAttribute{
+				MarkdownDescription: "The person accepting the risk, who doesn't have to be a DefectDojo User",
+				Optional:            true,
+			},
+			"recommendation": schema.StringAttribute{
+				MarkdownDescription: "The recommendation of the security team. One of `A` (Accept), `V` (Avoid), `M` (Mitigate), `F` (Fix) or `T` (Transfer). Defaults to `F`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "V", "M", "F", "T"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringDefault("F"),
+				},
+			},
+			"recommendation_details": schema.StringAttribute{
+				MarkdownDescription: "The explanation of the recommendation",
+				Optional:            true,
+			},
+			"decision": schema.StringAttribute{
+				MarkdownDescription: "The risk treatment decision of the risk owner. One of `A` (Accept), `V` (Avoid), `M` (Mitigate), `F` (Fix) or `T` (Transfer). Defaults to `A`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "V", "M", "F", "T"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringDefault("A"),
+				},
+			},
+			"decision_details": schema.StringAttribute{
+				MarkdownDescription: "The compensating controls, if any, that mitigate the Findings or reduce their risk",
+				Optional:            true,
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "When the Risk Acceptance expires, in RFC3339 format, for example `2024-01-31T00:00:00Z`. It never expires if this isn't set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utcTimestampRegexp, "Must be a timestamp in RFC3339 format, in UTC"),
+				},
+			},
+			"reactivate_expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Findings are reactivated when the Risk Acceptance expires. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(true),
+				},
+			},
+			"restart_sla_expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SLA of the Findings is restarted when the Risk Acceptance expires. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(false),
+				},
+			},
+			"proof_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a local file to upload as the proof of the Risk Acceptance. It is uploaded again when its contents change. Unsetting it leaves the last uploaded proof in place.",
+				Optional:            true,
+			},
+			"proof_file_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 digest of the contents of `proof_file` at the time of the upload",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					fileSha256Of("proof_file", false),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type riskAcceptanceResourceData struct {
+	Name                  types.String `tfsdk:"name" ddField:"Name"`
+	AcceptedFindingIds    types.Set    `tfsdk:"accepted_finding_ids" ddField:"AcceptedFindings"`
+	OwnerId               types.Int64  `tfsdk:"owner_id" ddField:"Owner"`
+	AcceptedBy            types.String `tfsdk:"accepted_by" ddField:"AcceptedBy"`
+	Recommendation        types.String `tfsdk:"recommendation" ddField:"Recommendation"`
+	RecommendationDetails types.String `tfsdk:"recommendation_details" ddField:"RecommendationDetails"`
+	Decision              types.String `tfsdk:"decision" ddField:"Decision"`
+	DecisionDetails       types.String `tfsdk:"decision_details" ddField:"DecisionDetails"`
+	ExpirationDate        types.String `tfsdk:"expiration_date" ddField:"ExpirationDate"`
+	ReactivateExpired     types.Bool   `tfsdk:"reactivate_expired" ddField:"ReactivateExpired"`
+	RestartSlaExpired     types.Bool   `tfsdk:"restart_sla_expired" ddField:"RestartSlaExpired"`
+	ProofFile             types.String `tfsdk:"proof_file" ddField:"ProofFile"`
+	ProofFileSha256       types.String `tfsdk:"proof_file_sha256" ddField:"ProofFileSha256"`
+	Id                    types.String `tfsdk:"id" ddField:"Id"`
+}
+
+// riskAcceptance is the Risk Acceptance model of the API. The client has a
+// type for it, but no operations, and its accepted findings are a plain
+// slice, which can't be populated from a set.
+type riskAcceptance struct {
+	Id                    int        `json:"id,omitempty"`
+	Name                  string     `json:"name"`
+	AcceptedFindings      *[]int     `json:"accepted_findings"`
+	Owner                 int        `json:"owner"`
+	AcceptedBy            *string    `json:"accepted_by"`
+	Recommendation        *string    `json:"recommendation,omitempty"`
+	RecommendationDetails *string    `json:"recommendation_details"`
+	Decision              *string    `json:"decision,omitempty"`
+	DecisionDetails       *string    `json:"decision_details"`
+	ExpirationDate        *time.Time `json:"expiration_date"`
+	ReactivateExpired     *bool      `json:"reactivate_expired,omitempty"`
+	RestartSlaExpired     *bool      `json:"restart_sla_expired,omitempty"`
+}
+
+const riskAcceptancesPath = "api/v2/risk_acceptance/"
+
+type riskAcceptanceDefectdojoResource struct {
+	riskAcceptance
+	ProofFile       *string
+	ProofFileSha256 *string
+}
+
+// uploadProof uploads the proof file, if one is set, to the Risk Acceptance,
+// unless its digest is the one of the proof uploaded last in ProofFileSha256.
+// The API only takes files as multipart form data, so this is a separate
+// request from the one that creates or updates the other attributes.
+func (ddr *riskAcceptanceDefectdojoResource) uploadProof(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	if ddr.ProofFile == nil {
+		ddr.ProofFileSha256 = nil
+		return 200, nil, nil
+	}
+
+	digest, err := fileSha256(*ddr.ProofFile)
+	if err != nil {
+		return 0, nil, err
+	}
+	if ddr.ProofFileSha256 != nil && *ddr.ProofFileSha256 == digest {
+		return 200, nil, nil
+	}
+
+	statusCode, body, err := rawMultipartApiCall(ctx, client, http.MethodPatch, fmt.Sprintf("%s%d/", riskAcceptancesPath, ddr.Id), nil, "path", *ddr.ProofFile, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode == 200 {
+		ddr.ProofFileSha256 = &digest
+	}
+
+	return statusCode, body, err
+}
+
+func (ddr *riskAcceptanceDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	var created riskAcceptance
+	statusCode, body, err := rawApiCall(ctx, client, http.MethodPost, riskAcceptancesPath, nil, ddr.riskAcceptance, &created)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode != 201 {
+		return statusCode, body, err
+	}
+	ddr.riskAcceptance = created
+
+	uploadStatusCode, uploadBody, err := ddr.uploadProof(ctx, client)
+	if err != nil || uploadStatusCode != 200 {
+		// a failed create isn't saved to the state, so the acceptance is removed rather than left
+		// to keep its findings accepted with nothing managing it
+		_, _, _ = ddr.deleteApiCall(ctx, client, ddr.Id)
+		return uploadStatusCode, uploadBody, err
+	}
+
+	return statusCode, body, err
+}
+
+func (ddr *riskAcceptanceDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	var read riskAcceptance
+	statusCode, body, err := rawApiCall(ctx, client, http.MethodGet, fmt.Sprintf("%s%d/", riskAcceptancesPath, idNumber), nil, nil, &read)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode == 200 {
+		// the proof can't be compared with the local file, so it's kept as it is in the state
+		ddr.riskAcceptance = read
+	}
+
+	return statusCode, body, err
+}
+
+func (ddr *riskAcceptanceDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	var updated riskAcceptance
+	statusCode, body, err := rawApiCall(ctx, client, http.MethodPut, fmt.Sprintf("%s%d/", riskAcceptancesPath, idNumber), nil, ddr.riskAcceptance, &updated)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode != 200 {
+		return statusCode, body, err
+	}
+	ddr.riskAcceptance = updated
+
+	// ProofFileSha256 holds the digest of the proof uploaded last, see riskAcceptanceResource.Update
+	return ddr.uploadProof(ctx, client)
+}
+
+func (ddr *riskAcceptanceDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// removing the acceptance reactivates its findings
+	return rawApiCall(ctx, client, http.MethodDelete, fmt.Sprintf("%s%d/", riskAcceptancesPath, idNumber), nil, nil, nil)
+}
+
+type riskAcceptanceResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &riskAcceptanceResource{}
+var _ resource.ResourceWithImportState = &riskAcceptanceResource{}
+
+func NewRiskAcceptanceResource() resource.Resource {
+	return &riskAcceptanceResource{
+		terraformResource: terraformResource{
+			dataProvider: riskAcceptanceDataProvider{},
+		},
+	}
+}
+
+func (r riskAcceptanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_risk_acceptance"
+}
+
+func (r riskAcceptanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// the plan holds the digest of the proof file as it is now, but the update compares it with the
+	// digest of the proof uploaded last, which is the one in the state
+	var uploaded types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("proof_file_sha256"), &uploaded)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("proof_file_sha256"), uploaded)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.terraformResource.Update(ctx, req, resp)
+}
+
+type riskAcceptanceDataProvider struct{}
+
+func (r riskAcceptanceDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data riskAcceptanceResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *riskAcceptanceResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *riskAcceptanceResourceData) defectdojoResource() defectdojoResource {
+	return &riskAcceptanceDefectdojoResource{
+		riskAcceptance: riskAcceptance{},
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRiskAcceptanceResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-finding-%s", resource.UniqueId())
+	name := fmt.Sprintf("dox-acceptance-%s", resource.UniqueId())
+	updatedName := fmt.Sprintf("dox-new-acceptance-%s", resource.UniqueId())
+	proofPath := filepath.Join(t.TempDir(), "proof.txt")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() { testAccWriteRiskAcceptanceProof(t, proofPath, "Signed off by the CISO") },
+				Config:    testAccRiskAcceptanceResourceConfig(productName, title, name, proofPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "name", name),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "accepted_finding_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("defectdojo_risk_acceptance.test", "accepted_finding_ids.0", "defectdojo_finding.test", "id"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "owner_id", "1"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "accepted_by", "The CISO"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "recommendation", "F"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "decision", "A"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "expiration_date", "2030-01-31T00:00:00Z"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "reactivate_expired", "true"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "restart_sla_expired", "false"),
+					resource.TestCheckResourceAttrSet("defectdojo_risk_acceptance.test", "proof_file_sha256"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_risk_acceptance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the proof can't be downloaded to compare it with a local file
+				ImportStateVerifyIgnore: []string{"proof_file", "proof_file_sha256"},
+			},
+			// The Finding is now risk accepted
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "risk_accepted", "true"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "active", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() { testAccWriteRiskAcceptanceProof(t, proofPath, "Signed off by the CTO") },
+				Config:    testAccRiskAcceptanceResourceUpdatedConfig(productName, title, updatedName, proofPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "name", updatedName),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "recommendation", "M"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "recommendation_details", "Patch in the next release"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "decision_details", "Behind the VPN"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "reactivate_expired", "false"),
+					resource.TestCheckResourceAttr("defectdojo_risk_acceptance.test", "restart_sla_expired", "true"),
+					resource.TestCheckNoResourceAttr("defectdojo_risk_acceptance.test", "accepted_by"),
+					resource.TestCheckNoResourceAttr("defectdojo_risk_acceptance.test", "expiration_date"),
+				),
+			},
+			// Destroying the acceptance reactivates the Finding
+			{
+				Config: testAccFindingResourceTestConfig(productName) + testAccRiskAcceptanceResourceFindingConfig(title),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "risk_accepted", "false"),
+					resource.TestCheckResourceAttr("defectdojo_finding.test", "active", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWriteRiskAcceptanceProof(t *testing.T, proofPath string, contents string) {
+	if err := os.WriteFile(proofPath, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccRiskAcceptanceResourceFindingConfig(title string) string {
+	return fmt.Sprintf(`
+resource "defectdojo_finding" "test" {
+  test_id = defectdojo_test.test.id
+  title = %[1]q
+  severity = "High"
+  description = "An injection in the login form"
+}
+`, title)
+}
+
+func testAccRiskAcceptanceResourceConfig(productName string, title string, name string, proofPath string) string {
+	return testAccFindingResourceTestConfig(productName) + testAccRiskAcceptanceResourceFindingConfig(title) + fmt.Sprintf(`
+resource "defectdojo_risk_acceptance" "test" {
+  name = %[1]q
+  accepted_finding_ids = [defectdojo_finding.test.id]
+  owner_id = 1
+  accepted_by = "The CISO"
+  expiration_date = "2030-01-31T00:00:00Z"
+  proof_file = %[2]q
+}
+`, name, proofPath)
+}
+
+func testAccRiskAcceptanceResourceUpdatedConfig(productName string, title string, name string, proofPath string) string {
+	return testAccFindingResourceTestConfig(productName) + testAccRiskAcceptanceResourceFindingConfig(title) + fmt.Sprintf(`
+resource "defectdojo_risk_acceptance" "test" {
+  name = %[1]q
+  accepted_finding_ids = [defectdojo_finding.test.id]
+  owner_id = 1
+  recommendation = "M"
+  recommendation_details = "Patch in the next release"
+  decision_details = "Behind the VPN"
+  reactivate_expired = false
+  restart_sla_expired = true
+  proof_file = %[2]q
+}
+`, name, proofPath)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"gotest.tools/assert"
+)
+
+// riskAcceptanceServer creates Risk Acceptances with the ID 3, answers the proof uploads with the
+// given status code, and records the requests it gets.
+func riskAcceptanceServer(t *testing.T, uploadStatusCode int, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, r.URL.Path, "/api/v2/risk_acceptance/")
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id": 3, "name": "Accepted", "accepted_findings": [1], "owner": 1, "decision": "A", "recommendation": "F"}`))
+		case http.MethodPut:
+			assert.Equal(t, r.URL.Path, "/api/v2/risk_acceptance/3/")
+			_, _ = w.Write([]byte(`{"id": 3, "name": "Accepted", "accepted_findings": [1], "owner": 1, "decision": "A", "recommendation": "F"}`))
+		case http.MethodPatch:
+			assert.NilError(t, r.ParseMultipartForm(1<<20))
+			_, _, err := r.FormFile("path")
+			assert.NilError(t, err)
+			w.WriteHeader(uploadStatusCode)
+			_, _ = w.Write([]byte(`{"id": 3}`))
+		case http.MethodDelete:
+			w.WriteHeader(204)
+		}
+	}))
+}
+
+func TestRiskAcceptanceResourceCreateUploadsProof(t *testing.T) {
+	proofPath := filepath.Join(t.TempDir(), "proof.txt")
+	assert.NilError(t, os.WriteFile(proofPath, []byte("Signed off"), 0600))
+
+	var requests []string
+	server := riskAcceptanceServer(t, 200, &requests)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddAcceptance := riskAcceptanceDefectdojoResource{
+		riskAcceptance: riskAcceptance{
+			Name:             "Accepted",
+			AcceptedFindings: &[]int{1},
+			Owner:            1,
+		},
+		ProofFile: ref.Of(proofPath),
+	}
+	statusCode, _, err := ddAcceptance.createApiCall(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+	assert.DeepEqual(t, requests, []string{"POST /api/v2/risk_acceptance/", "PATCH /api/v2/risk_acceptance/3/"})
+	assert.Equal(t, ddAcceptance.Id, 3)
+	assert.Equal(t, *ddAcceptance.Decision, "A")
+
+	digest, err := fileSha256(proofPath)
+	assert.NilError(t, err)
+	assert.Equal(t, *ddAcceptance.ProofFileSha256, digest)
+}
+
+func TestRiskAcceptanceResourceCreateWithoutProof(t *testing.T) {
+	var requests []string
+	server := riskAcceptanceServer(t, 200, &requests)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddAcceptance := riskAcceptanceDefectdojoResource{
+		riskAcceptance: riskAcceptance{Name: "Accepted", AcceptedFindings: &[]int{1}, Owner: 1},
+	}
+	statusCode, _, err := ddAcceptance.createApiCall(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 201)
+	assert.DeepEqual(t, requests, []string{"POST /api/v2/risk_acceptance/"})
+	assert.Assert(t, ddAcceptance.ProofFileSha256 == nil)
+}
+
+func TestRiskAcceptanceResourceCreateRemovesAcceptanceWhenUploadFails(t *testing.T) {
+	proofPath := filepath.Join(t.TempDir(), "proof.txt")
+	assert.NilError(t, os.WriteFile(proofPath, []byte("Signed off"), 0600))
+
+	var requests []string
+	server := riskAcceptanceServer(t, 400, &requests)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddAcceptance := riskAcceptanceDefectdojoResource{
+		riskAcceptance: riskAcceptance{Name: "Accepted", AcceptedFindings: &[]int{1}, Owner: 1},
+		ProofFile:      ref.Of(proofPath),
+	}
+	statusCode, _, err := ddAcceptance.createApiCall(context.Background(), client)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 400)
+	assert.DeepEqual(t, requests, []string{"POST /api/v2/risk_acceptance/", "PATCH /api/v2/risk_acceptance/3/", "DELETE /api/v2/risk_acceptance/3/"})
+}
+
+func TestRiskAcceptanceResourceUpdateUploadsChangedProof(t *testing.T) {
+	proofPath := filepath.Join(t.TempDir(), "proof.txt")
+	assert.NilError(t, os.WriteFile(proofPath, []byte("Signed off"), 0600))
+	digest, err := fileSha256(proofPath)
+	assert.NilError(t, err)
+
+	var requests []string
+	server := riskAcceptanceServer(t, 200, &requests)
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	// the same proof as the one uploaded last isn't uploaded again
+	ddAcceptance := riskAcceptanceDefectdojoResource{
+		riskAcceptance:  riskAcceptance{Name: "Accepted", AcceptedFindings: &[]int{1}, Owner: 1},
+		ProofFile:       ref.Of(proofPath),
+		ProofFileSha256: ref.Of(digest),
+	}
+	statusCode, _, err := ddAcceptance.updateApiCall(context.Background(), client, 3)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 200)
+	assert.DeepEqual(t, requests, []string{"PUT /api/v2/risk_acceptance/3/"})
+	assert.Equal(t, *ddAcceptance.ProofFileSha256, digest)
+
+	// a changed one is
+	assert.NilError(t, os.WriteFile(proofPath, []byte("Signed off again"), 0600))
+	requests = nil
+	statusCode, _, err = ddAcceptance.updateApiCall(context.Background(), client, 3)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 200)
+	assert.DeepEqual(t, requests, []string{"PUT /api/v2/risk_acceptance/3/", "PATCH /api/v2/risk_acceptance/3/"})
+	changedDigest, err := fileSha256(proofPath)
+	assert.NilError(t, err)
+	assert.Equal(t, *ddAcceptance.ProofFileSha256, changedDigest)
+}
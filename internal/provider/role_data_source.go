@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t roleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo Role. Looks up a Role (`Reader`, `Writer`, `Maintainer`, `Owner` or `API_Importer`) by its name, so that role ids don't have to be hardcoded.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Role",
+				Required:            true,
+			},
+			"is_owner": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Role grants ownership",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type roleDataSourceData struct {
+	Name    types.String `tfsdk:"name"`
+	IsOwner types.Bool   `tfsdk:"is_owner"`
+	Id      types.String `tfsdk:"id"`
+}
+
+type roleDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d roleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &roleDataSource{}
+}
+
+func (r *roleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data roleDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := dd.RolesListParams{
+		Name: ref.Of(data.Name.ValueString()),
+	}
+
+	apiResp, err := d.client.RolesListWithResponse(ctx, &params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+
+	if apiResp.StatusCode() == 200 {
+		var role dd.Role
+		if *apiResp.JSON200.Count == 0 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				"No Roles matched the given parameters.")
+			return
+		} else if *apiResp.JSON200.Count > 1 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				fmt.Sprintf("%d Roles matched the given parameters.\n\nResponse:\n\n%s", *apiResp.JSON200.Count, apiResp.Body))
+			return
+		} else {
+			role = (*apiResp.JSON200.Results)[0]
+
+			data.Id = types.StringValue(fmt.Sprintf("%d", role.Id))
+			data.Name = types.StringValue(role.Name)
+			data.IsOwner = types.BoolValue(role.IsOwner != nil && *role.IsOwner)
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"API Error Retrieving Data Source",
+			fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+				fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRoleDataSourceConfig("Reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.defectdojo_role.test", "name", "Reader"),
+					resource.TestCheckResourceAttr("data.defectdojo_role.test", "id", "5"),
+					resource.TestCheckResourceAttr("data.defectdojo_role.test", "is_owner", "false"),
+				),
+			},
+			{
+				Config: testAccRoleDataSourceConfig("Owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.defectdojo_role.test", "name", "Owner"),
+					resource.TestCheckResourceAttr("data.defectdojo_role.test", "is_owner", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleDataSourceNoMatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Roles matched the given parameters`),
+				Config:      testAccRoleDataSourceConfig("Nonexistent"),
+			},
+		},
+	})
+}
+
+func testAccRoleDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_role" "test" {
+  name = %q
+}
+`, name)
+}
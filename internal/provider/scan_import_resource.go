@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t scanImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Imports a local scan report into DefectDojo, creating a new Test. Changing any attribute, or the contents of the report file, performs a new import. Destroying this resource deletes the Test that was created by the import.",
+
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path to the scan report file to upload",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 digest of the contents of `file` at the time of the import",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					fileSha256Of("file", true),
+				},
+			},
+			"scan_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the scan report, e.g. `ZAP Scan` or `Trivy Scan`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engagement_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Engagement to import the scan into. Either `engagement_id` must be set, or `product_name` and `engagement_name` to look the Engagement up by (and create it, with `auto_create_context`).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("engagement_name")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"test_title": schema.StringAttribute{
+				MarkdownDescription: "The title of the Test created by the import",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_create_context": schema.BoolAttribute{
+				MarkdownDescription: "If set, the Product Type, Product and Engagement given by name are created if they do not exist yet",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("product_name"), path.MatchRoot("engagement_name")),
+				},
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_type_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Product Type, used with `auto_create_context`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("auto_create_context")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Product to import the scan into when `engagement_id` is not set",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("engagement_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engagement_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Engagement to import the scan into when `engagement_id` is not set",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("product_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"minimum_severity": schema.StringAttribute{
+				MarkdownDescription: "Findings below this severity are not imported. Valid values are: 'Info', 'Low', 'Medium', 'High', 'Critical'",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Info", "Low", "Medium", "High", "Critical"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the imported findings are marked active",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the imported findings are marked verified",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"close_old_findings": schema.BoolAttribute{
+				MarkdownDescription: "Close findings of the same scan type in the Engagement that are no longer present in the report",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"scan_date": schema.StringAttribute{
+				MarkdownDescription: "The date of the scan in YYYY-MM-DD format. Defaults to the date of the import.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\A\d{4}-\d{2}-\d{2}\z`), "Must be a date in YYYY-MM-DD format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version that was scanned",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"build_id": schema.StringAttribute{
+				MarkdownDescription: "Build ID that was scanned",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag or branch that was scanned",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Commit hash that was scanned",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply to the Test created by the import",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[a-z]+\z`), "Tags must be lower case values"),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"test_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Test created by the import",
+				Computed:            true,
+			},
+			"findings_created": schema.Int64Attribute{
+				MarkdownDescription: "The number of findings created by the import",
+				Computed:            true,
+			},
+			"findings_closed": schema.Int64Attribute{
+				MarkdownDescription: "The number of findings closed by the import",
+				Computed:            true,
+			},
+			"findings_reactivated": schema.Int64Attribute{
+				MarkdownDescription: "The number of findings reactivated by the import",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type scanImportResourceData struct {
+	File                types.String `tfsdk:"file" ddField:"File"`
+	FileSha256          types.String `tfsdk:"file_sha256" ddField:"FileSha256"`
+	ScanType            types.String `tfsdk:"scan_type" ddField:"ScanType"`
+	EngagementId        types.Int64  `tfsdk:"engagement_id" ddField:"Engagement"`
+	TestTitle           types.String `tfsdk:"test_title" ddField:"TestTitle"`
+	AutoCreateContext   types.Bool   `tfsdk:"auto_create_context" ddField:"AutoCreateContext"`
+	ProductTypeName     types.String `tfsdk:"product_type_name" ddField:"ProductTypeName"`
+	ProductName         types.String `tfsdk:"product_name" ddField:"ProductName"`
+	EngagementName      types.String `tfsdk:"engagement_name" ddField:"EngagementName"`
+	MinimumSeverity     types.String `tfsdk:"minimum_severity" ddField:"MinimumSeverity"`
+	Active              types.Bool   `tfsdk:"active" ddField:"Active"`
+	Verified            types.Bool   `tfsdk:"verified" ddField:"Verified"`
+	CloseOldFindings    types.Bool   `tfsdk:"close_old_findings" ddField:"CloseOldFindings"`
+	ScanDate            types.String `tfsdk:"scan_date" ddField:"ScanDate"`
+	Version             types.String `tfsdk:"version" ddField:"Version"`
+	BuildId             types.String `tfsdk:"build_id" ddField:"BuildId"`
+	BranchTag           types.String `tfsdk:"branch_tag" ddField:"BranchTag"`
+	CommitHash          types.String `tfsdk:"commit_hash" ddField:"CommitHash"`
+	Tags                types.Set    `tfsdk:"tags" ddField:"Tags"`
+	TestId              types.Int64  `tfsdk:"test_id" ddField:"Test"`
+	FindingsCreated     types.Int64  `tfsdk:"findings_created" ddField:"FindingsCreated"`
+	FindingsClosed      types.Int64  `tfsdk:"findings_closed" ddField:"FindingsClosed"`
+	FindingsReactivated types.Int64  `tfsdk:"findings_reactivated" ddField:"FindingsReactivated"`
+	Id                  types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type scanImportDefectdojoResource struct {
+	dd.ImportScan
+	Id                  int
+	FileSha256          string
+	FindingsCreated     *int
+	FindingsClosed      *int
+	FindingsReactivated *int
+}
+
+// formFields returns the settings of the import as multipart form fields.
+func (ddr *scanImportDefectdojoResource) formFields() url.Values {
+	fields := url.Values{}
+	fields.Set("scan_type", string(ddr.ScanType))
+	if ddr.Engagement != nil {
+		fields.Set("engagement", strconv.Itoa(*ddr.Engagement))
+	}
+	setStringField(fields, "test_title", ddr.TestTitle)
+	setBoolField(fields, "auto_create_context", ddr.AutoCreateContext)
+	setStringField(fields, "product_type_name", ddr.ProductTypeName)
+	setStringField(fields, "product_name", ddr.ProductName)
+	setStringField(fields, "engagement_name", ddr.EngagementName)
+	if ddr.MinimumSeverity != nil {
+		fields.Set("minimum_severity", string(*ddr.MinimumSeverity))
+	}
+	setBoolField(fields, "active", ddr.Active)
+	setBoolField(fields, "verified", ddr.Verified)
+	setBoolField(fields, "close_old_findings", ddr.CloseOldFindings)
+	if ddr.ScanDate != nil {
+		fields.Set("scan_date", ddr.ScanDate.String())
+	}
+	setStringField(fields, "version", ddr.Version)
+	setStringField(fields, "build_id", ddr.BuildId)
+	setStringField(fields, "branch_tag", ddr.BranchTag)
+	setStringField(fields, "commit_hash", ddr.CommitHash)
+	if ddr.Tags != nil {
+		for _, tag := range *ddr.Tags {
+			fields.Add("tags", tag)
+		}
+	}
+	return fields
+}
+
+func (ddr *scanImportDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	if ddr.File == nil {
+		return 0, nil, fmt.Errorf("no scan report file was given")
+	}
+
+	digest, err := fileSha256(*ddr.File)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	body, contentType, err := newMultipartFileBody(ddr.formFields(), "file", *ddr.File)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	apiResp, err := client.ImportScanCreateWithBodyWithResponse(ctx, contentType, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		// keep the settings we sent rather than the ones echoed back, only the outcome of the import
+		// is taken from the response
+		ddr.Id = apiResp.JSON201.Test
+		ddr.Test = apiResp.JSON201.Test
+		ddr.FileSha256 = digest
+		ddr.FindingsCreated, ddr.FindingsClosed, ddr.FindingsReactivated = importStatisticsCounts(apiResp.JSON201.Statistics.ImportStatistics)
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *scanImportDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// an import can't be read back, so we only check that the Test it created still exists
+	apiResp, err := client.TestsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *scanImportDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// every attribute requires replacement, so there is nothing to update in place
+	return ddr.readApiCall(ctx, client, idNumber)
+}
+
+func (ddr *scanImportDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.TestsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+// importStatisticsCounts returns the number of created, closed and reactivated findings from the
+// statistics of an import or reimport. These are only reported when DefectDojo tracks the import
+// history, otherwise they are nil.
+func importStatisticsCounts(stats dd.ImportStatistics) (*int, *int, *int) {
+	if stats.Delta == nil {
+		return nil, nil, nil
+	}
+	created := stats.Delta.Created.Total.Total
+	closed := stats.Delta.Closed.Total.Total
+	reactivated := stats.Delta.Reactivated.Total.Total
+	return &created, &closed, &reactivated
+}
+
+func setStringField(fields url.Values, key string, value *string) {
+	if value != nil {
+		fields.Set(key, *value)
+	}
+}
+
+func setBoolField(fields url.Values, key string, value *bool) {
+	if value != nil {
+		fields.Set(key, strconv.FormatBool(*value))
+	}
+}
+
+type scanImportResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &scanImportResource{}
+
+func NewScanImportResource() resource.Resource {
+	return &scanImportResource{
+		terraformResource: terraformResource{
+			dataProvider: scanImportDataProvider{},
+		},
+	}
+}
+
+func (r scanImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scan_import"
+}
+
+func (r scanImportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"Import Not Supported",
+		"A scan import can not be imported, since the report file it uploaded can not be retrieved from the API.")
+}
+
+type scanImportDataProvider struct{}
+
+func (r scanImportDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data scanImportResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *scanImportResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *scanImportResourceData) defectdojoResource() defectdojoResource {
+	return &scanImportDefectdojoResource{
+		ImportScan: dd.ImportScan{},
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccScanImportResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	var firstTestId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() { testAccWriteGenericFindingsReport(t, reportPath, "First Finding") },
+				Config:    testAccScanImportResourceConfig(productName, reportPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_scan_import.test", "scan_type", "Generic Findings Import"),
+					resource.TestCheckResourceAttr("defectdojo_scan_import.test", "test_title", "terraform import"),
+					resource.TestCheckResourceAttr("defectdojo_scan_import.test", "minimum_severity", "Info"),
+					resource.TestCheckResourceAttr("defectdojo_scan_import.test", "scan_date", "2023-01-01"),
+					resource.TestCheckResourceAttrSet("defectdojo_scan_import.test", "test_id"),
+					resource.TestCheckResourceAttrSet("defectdojo_scan_import.test", "file_sha256"),
+					resource.TestCheckResourceAttrPair("defectdojo_scan_import.test", "id", "defectdojo_scan_import.test", "test_id"),
+					testAccCaptureAttr("defectdojo_scan_import.test", "test_id", &firstTestId),
+				),
+			},
+			// Changing the contents of the report performs a new import
+			{
+				PreConfig: func() { testAccWriteGenericFindingsReport(t, reportPath, "Second Finding") },
+				Config:    testAccScanImportResourceConfig(productName, reportPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("defectdojo_scan_import.test", "test_id"),
+					func(s *terraform.State) error {
+						testId := s.RootModule().Resources["defectdojo_scan_import.test"].Primary.Attributes["test_id"]
+						if testId == firstTestId {
+							return fmt.Errorf("expected a new import, but the test id is still %s", testId)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccScanImportResourceInvalidContext(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// neither an Engagement nor the names to look it up by
+			{
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_scan_import" "test" {
+  file = %q
+  scan_type = "Generic Findings Import"
+}
+`, reportPath),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// creating the context needs the names of what to create
+			{
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_scan_import" "test" {
+  file = %q
+  scan_type = "Generic Findings Import"
+  auto_create_context = true
+  engagement_name = "CI"
+}
+`, reportPath),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccWriteGenericFindingsReport(t *testing.T, reportPath string, title string) {
+	report := fmt.Sprintf(`{"findings": [{"title": %q, "description": "test", "severity": "High", "date": "2023-01-01"}]}`, title)
+	if err := os.WriteFile(reportPath, []byte(report), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCaptureAttr(resourceName string, attr string, dest *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		*dest = rs.Primary.Attributes[attr]
+		return nil
+	}
+}
+
+func testAccScanImportResourceConfig(productName string, reportPath string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+}
+resource "defectdojo_scan_import" "test" {
+  file = %[2]q
+  scan_type = "Generic Findings Import"
+  engagement_id = defectdojo_engagement.test.id
+  test_title = "terraform import"
+  minimum_severity = "Info"
+  active = true
+  verified = false
+  scan_date = "2023-01-01"
+}
+`, productName, reportPath)
+}
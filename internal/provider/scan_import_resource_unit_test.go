@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"gotest.tools/assert"
+)
+
+func TestScanImportResource__formFields(t *testing.T) {
+	minimumSeverity := dd.ImportScanMinimumSeverity("High")
+	ddResource := scanImportDefectdojoResource{
+		ImportScan: dd.ImportScan{
+			ScanType:        "ZAP Scan",
+			Engagement:      ref.Of(42),
+			MinimumSeverity: &minimumSeverity,
+			Active:          ref.Of(true),
+			Verified:        ref.Of(false),
+			ScanDate:        &openapi_types.Date{Time: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+			BranchTag:       ref.Of("main"),
+			Tags:            &[]string{"foo", "bar"},
+		},
+	}
+
+	fields := ddResource.formFields()
+	assert.Equal(t, fields.Get("scan_type"), "ZAP Scan")
+	assert.Equal(t, fields.Get("engagement"), "42")
+	assert.Equal(t, fields.Get("minimum_severity"), "High")
+	assert.Equal(t, fields.Get("active"), "true")
+	assert.Equal(t, fields.Get("verified"), "false")
+	assert.Equal(t, fields.Get("scan_date"), "2023-01-02")
+	assert.Equal(t, fields.Get("branch_tag"), "main")
+	assert.DeepEqual(t, fields["tags"], []string{"foo", "bar"})
+
+	// unset values are left out so that the API applies its defaults
+	_, ok := fields["close_old_findings"]
+	assert.Equal(t, ok, false)
+	_, ok = fields["commit_hash"]
+	assert.Equal(t, ok, false)
+}
+
+func TestScanImportResource__importStatisticsCounts(t *testing.T) {
+	created, closed, reactivated := importStatisticsCounts(dd.ImportStatistics{})
+	assert.Assert(t, created == nil)
+	assert.Assert(t, closed == nil)
+	assert.Assert(t, reactivated == nil)
+
+	stats := dd.ImportStatistics{}
+	stats.Delta = &struct {
+		dd.DeltaStatistics `yaml:",inline"`
+	}{}
+	stats.Delta.Created.Total.Total = 3
+	stats.Delta.Closed.Total.Total = 2
+	stats.Delta.Reactivated.Total.Total = 1
+
+	created, closed, reactivated = importStatisticsCounts(stats)
+	assert.Equal(t, *created, 3)
+	assert.Equal(t, *closed, 2)
+	assert.Equal(t, *reactivated, 1)
+}
+
+func TestNewMultipartFileBody(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	assert.NilError(t, os.WriteFile(reportPath, []byte(`{"findings": []}`), 0600))
+
+	scan := scanImportDefectdojoResource{ImportScan: dd.ImportScan{ScanType: "Generic Findings Import"}}
+	body, contentType, err := newMultipartFileBody(scan.formFields(), "file", reportPath)
+	assert.NilError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	assert.NilError(t, err)
+	assert.Equal(t, mediaType, "multipart/form-data")
+
+	reader := multipart.NewReader(body, params["boundary"])
+	form, err := reader.ReadForm(1 << 20)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, form.Value["scan_type"], []string{"Generic Findings Import"})
+	assert.Equal(t, form.File["file"][0].Filename, "report.json")
+
+	f, err := form.File["file"][0].Open()
+	assert.NilError(t, err)
+	contents, err := io.ReadAll(f)
+	assert.NilError(t, err)
+	assert.Equal(t, string(contents), `{"findings": []}`)
+
+	digest, err := fileSha256(reportPath)
+	assert.NilError(t, err)
+	assert.Equal(t, len(digest), 64)
+}
+
+func TestScanImportResourceTransportErrors(t *testing.T) {
+	report := filepath.Join(t.TempDir(), "report.json")
+	assert.NilError(t, os.WriteFile(report, []byte(`{}`), 0600))
+
+	assertTransportErrors(t, &scanImportDefectdojoResource{
+		ImportScan: dd.ImportScan{ScanType: "ZAP Scan", File: &report},
+	})
+}
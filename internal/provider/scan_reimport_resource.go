@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t scanReimportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reimports a local scan report into an existing DefectDojo Test, updating its Findings in place. The report is uploaded again only when the contents of the report file change; changes to the other settings take effect on the next upload. Destroying this resource leaves the Test and its Findings in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"test_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Test to reimport the scan into",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path to the scan report file to upload",
+				Required:            true,
+			},
+			"file_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 digest of the contents of `file` at the time of the last upload",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					fileSha256Of("file", false),
+				},
+			},
+			"scan_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the scan report, e.g. `ZAP Scan` or `Trivy Scan`. Must match the scan type of the Test.",
+				Required:            true,
+			},
+			"minimum_severity": schema.StringAttribute{
+				MarkdownDescription: "Findings below this severity are not imported. Valid values are: 'Info', 'Low', 'Medium', 'High', 'Critical'",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Info", "Low", "Medium", "High", "Critical"),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the imported findings are marked active",
+				Optional:            true,
+			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the imported findings are marked verified",
+				Optional:            true,
+			},
+			"do_not_reactivate": schema.BoolAttribute{
+				MarkdownDescription: "Do not reactivate findings that were closed in DefectDojo but are present in the report again",
+				Optional:            true,
+			},
+			"close_old_findings": schema.BoolAttribute{
+				MarkdownDescription: "Close findings of the Test that are no longer present in the report",
+				Optional:            true,
+			},
+			"close_old_findings_product_scope": schema.BoolAttribute{
+				MarkdownDescription: "Together with `close_old_findings`, close findings of the same scan type in the whole Product that are no longer present in the report",
+				Optional:            true,
+			},
+			"scan_date": schema.StringAttribute{
+				MarkdownDescription: "The date of the scan in YYYY-MM-DD format. Defaults to the date of the upload.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\A\d{4}-\d{2}-\d{2}\z`), "Must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version that was scanned",
+				Optional:            true,
+			},
+			"build_id": schema.StringAttribute{
+				MarkdownDescription: "Build ID that was scanned",
+				Optional:            true,
+			},
+			"branch_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag or branch that was scanned",
+				Optional:            true,
+			},
+			"commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Commit hash that was scanned",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply to the Test",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[a-z]+\z`), "Tags must be lower case values"),
+					),
+				},
+			},
+			"findings_created": schema.Int64Attribute{
+				MarkdownDescription: "The number of findings created by the last upload",
+				Computed:            true,
+			},
+			"findings_closed": schema.Int64Attribute{
+				MarkdownDescription: "The number of findings closed by the last upload",
+				Computed:            true,
+			},
+			"findings_reactivated": schema.Int64Attribute{
+				MarkdownDescription: "The number of findings reactivated by the last upload",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type scanReimportResourceData struct {
+	TestId                       types.Int64  `tfsdk:"test_id" ddField:"TestId"`
+	File                         types.String `tfsdk:"file" ddField:"File"`
+	FileSha256                   types.String `tfsdk:"file_sha256" ddField:"FileSha256"`
+	ScanType                     types.String `tfsdk:"scan_type" ddField:"ScanType"`
+	MinimumSeverity              types.String `tfsdk:"minimum_severity" ddField:"MinimumSeverity"`
+	Active                       types.Bool   `tfsdk:"active" ddField:"Active"`
+	Verified                     types.Bool   `tfsdk:"verified" ddField:"Verified"`
+	DoNotReactivate              types.Bool   `tfsdk:"do_not_reactivate" ddField:"DoNotReactivate"`
+	CloseOldFindings             types.Bool   `tfsdk:"close_old_findings" ddField:"CloseOldFindings"`
+	CloseOldFindingsProductScope types.Bool   `tfsdk:"close_old_findings_product_scope" ddField:"CloseOldFindingsProductScope"`
+	ScanDate                     types.String `tfsdk:"scan_date" ddField:"ScanDate"`
+	Version                      types.String `tfsdk:"version" ddField:"Version"`
+	BuildId                      types.String `tfsdk:"build_id" ddField:"BuildId"`
+	BranchTag                    types.String `tfsdk:"branch_tag" ddField:"BranchTag"`
+	CommitHash                   types.String `tfsdk:"commit_hash" ddField:"CommitHash"`
+	Tags                         types.Set    `tfsdk:"tags" ddField:"Tags"`
+	FindingsCreated              types.Int64  `tfsdk:"findings_created" ddField:"FindingsCreated"`
+	FindingsClosed               types.Int64  `tfsdk:"findings_closed" ddField:"FindingsClosed"`
+	FindingsReactivated          types.Int64  `tfsdk:"findings_reactivated" ddField:"FindingsReactivated"`
+	Id                           types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type scanReimportDefectdojoResource struct {
+	dd.ReImportScan
+	Id         int
+	FileSha256 string
+	// the client predates these reimport settings, but we send the form ourselves so we can add them
+	DoNotReactivate              *bool
+	CloseOldFindingsProductScope *bool
+	FindingsCreated              *int
+	FindingsClosed               *int
+	FindingsReactivated          *int
+}
+
+// formFields returns the settings of the reimport as multipart form fields.
+func (ddr *scanReimportDefectdojoResource) formFields() url.Values {
+	fields := url.Values{}
+	fields.Set("scan_type", string(ddr.ScanType))
+	fields.Set("test", strconv.Itoa(ddr.TestId))
+	if ddr.MinimumSeverity != nil {
+		fields.Set("minimum_severity", string(*ddr.MinimumSeverity))
+	}
+	setBoolField(fields, "active", ddr.Active)
+	setBoolField(fields, "verified", ddr.Verified)
+	setBoolField(fields, "do_not_reactivate", ddr.DoNotReactivate)
+	setBoolField(fields, "close_old_findings", ddr.CloseOldFindings)
+	setBoolField(fields, "close_old_findings_product_scope", ddr.CloseOldFindingsProductScope)
+	if ddr.ScanDate != nil {
+		fields.Set("scan_date", ddr.ScanDate.String())
+	}
+	setStringField(fields, "version", ddr.Version)
+	setStringField(fields, "build_id", ddr.BuildId)
+	setStringField(fields, "branch_tag", ddr.BranchTag)
+	setStringField(fields, "commit_hash", ddr.CommitHash)
+	if ddr.Tags != nil {
+		for _, tag := range *ddr.Tags {
+			fields.Add("tags", tag)
+		}
+	}
+	return fields
+}
+
+// reimport uploads the report file to the reimport endpoint and records the outcome.
+func (ddr *scanReimportDefectdojoResource) reimport(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	if ddr.File == nil {
+		return 0, nil, fmt.Errorf("no scan report file was given")
+	}
+
+	digest, err := fileSha256(*ddr.File)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	body, contentType, err := newMultipartFileBody(ddr.formFields(), "file", *ddr.File)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	apiResp, err := client.ReimportScanCreateWithBodyWithResponse(ctx, contentType, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.Id = ddr.TestId
+		ddr.FileSha256 = digest
+		ddr.FindingsCreated, ddr.FindingsClosed, ddr.FindingsReactivated = importStatisticsCounts(apiResp.JSON201.Statistics.ImportStatistics)
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *scanReimportDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	return ddr.reimport(ctx, client)
+}
+
+func (ddr *scanReimportDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// a reimport can't be read back, so we only check that the Test still exists
+	apiResp, err := client.TestsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *scanReimportDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	statusCode, body, err := ddr.reimport(ctx, client)
+	if statusCode == 201 {
+		// every reimport is a creation as far as the API is concerned, but for us it updates the Test
+		statusCode = 200
+	}
+	return statusCode, body, err
+}
+
+func (ddr *scanReimportDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// the Test is not owned by this resource, so there is nothing to delete
+	return 204, nil, nil
+}
+
+type scanReimportResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &scanReimportResource{}
+
+func NewScanReimportResource() resource.Resource {
+	return &scanReimportResource{
+		terraformResource: terraformResource{
+			dataProvider: scanReimportDataProvider{},
+		},
+	}
+}
+
+func (r scanReimportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scan_reimport"
+}
+
+func (r scanReimportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state scanReimportResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.FileSha256.Equal(state.FileSha256) {
+		r.terraformResource.Update(ctx, req, resp)
+		return
+	}
+
+	// The report has not changed, so it is not uploaded again. The new settings are
+	// recorded and take effect on the next upload.
+	plan.FindingsCreated = state.FindingsCreated
+	plan.FindingsClosed = state.FindingsClosed
+	plan.FindingsReactivated = state.FindingsReactivated
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r scanReimportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"Import Not Supported",
+		"A scan reimport can not be imported, since the report file it uploaded can not be retrieved from the API.")
+}
+
+type scanReimportDataProvider struct{}
+
+func (r scanReimportDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data scanReimportResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *scanReimportResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *scanReimportResourceData) defectdojoResource() defectdojoResource {
+	return &scanReimportDefectdojoResource{
+		ReImportScan: dd.ReImportScan{},
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccScanReimportResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	importPath := filepath.Join(t.TempDir(), "import.json")
+	reimportPath := filepath.Join(t.TempDir(), "reimport.json")
+	var firstSha256 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					testAccWriteGenericFindingsReport(t, importPath, "First Finding")
+					testAccWriteGenericFindingsReport(t, reimportPath, "Second Finding")
+				},
+				Config: testAccScanReimportResourceConfig(productName, importPath, reimportPath, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_scan_reimport.test", "test_id", "defectdojo_scan_import.test", "test_id"),
+					resource.TestCheckResourceAttrPair("defectdojo_scan_reimport.test", "id", "defectdojo_scan_import.test", "test_id"),
+					resource.TestCheckResourceAttr("defectdojo_scan_reimport.test", "close_old_findings", "false"),
+					resource.TestCheckResourceAttrSet("defectdojo_scan_reimport.test", "file_sha256"),
+					testAccCaptureAttr("defectdojo_scan_reimport.test", "file_sha256", &firstSha256),
+				),
+			},
+			// Changing a setting without changing the report is recorded without an upload
+			{
+				Config: testAccScanReimportResourceConfig(productName, importPath, reimportPath, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_scan_reimport.test", "close_old_findings", "true"),
+					resource.TestCheckResourceAttrPtr("defectdojo_scan_reimport.test", "file_sha256", &firstSha256),
+				),
+			},
+			// Changing the contents of the report uploads it again into the same Test
+			{
+				PreConfig: func() { testAccWriteGenericFindingsReport(t, reimportPath, "Third Finding") },
+				Config:    testAccScanReimportResourceConfig(productName, importPath, reimportPath, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("defectdojo_scan_reimport.test", "test_id", "defectdojo_scan_import.test", "test_id"),
+					func(s *terraform.State) error {
+						sha := s.RootModule().Resources["defectdojo_scan_reimport.test"].Primary.Attributes["file_sha256"]
+						if sha == firstSha256 {
+							return fmt.Errorf("expected the report to be uploaded again, but file_sha256 is still %s", sha)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccScanReimportResourceConfig(productName string, importPath string, reimportPath string, closeOldFindings bool) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+}
+resource "defectdojo_scan_import" "test" {
+  file = %[2]q
+  scan_type = "Generic Findings Import"
+  engagement_id = defectdojo_engagement.test.id
+}
+resource "defectdojo_scan_reimport" "test" {
+  test_id = defectdojo_scan_import.test.test_id
+  file = %[3]q
+  scan_type = "Generic Findings Import"
+  do_not_reactivate = true
+  close_old_findings = %[4]t
+  close_old_findings_product_scope = false
+}
+`, productName, importPath, reimportPath, closeOldFindings)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestScanReimportResource__formFields(t *testing.T) {
+	ddResource := scanReimportDefectdojoResource{
+		ReImportScan: dd.ReImportScan{
+			ScanType:         "ZAP Scan",
+			TestId:           7,
+			CloseOldFindings: ref.Of(true),
+		},
+		DoNotReactivate:              ref.Of(true),
+		CloseOldFindingsProductScope: ref.Of(false),
+	}
+
+	fields := ddResource.formFields()
+	assert.Equal(t, fields.Get("scan_type"), "ZAP Scan")
+	assert.Equal(t, fields.Get("test"), "7")
+	assert.Equal(t, fields.Get("do_not_reactivate"), "true")
+	assert.Equal(t, fields.Get("close_old_findings"), "true")
+	assert.Equal(t, fields.Get("close_old_findings_product_scope"), "false")
+	_, ok := fields["active"]
+	assert.Equal(t, ok, false)
+}
+
+func TestScanReimportResource__defectdojoResource(t *testing.T) {
+	reimportResource := scanReimportResourceData{
+		TestId:                       types.Int64Value(7),
+		File:                         types.StringValue("report.json"),
+		ScanType:                     types.StringValue("ZAP Scan"),
+		DoNotReactivate:              types.BoolValue(true),
+		CloseOldFindingsProductScope: types.BoolNull(),
+		Tags:                         types.SetNull(types.StringType),
+	}
+	var terraformResource terraformResourceData = &reimportResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddReimport := ddResource.(*scanReimportDefectdojoResource)
+	assert.Equal(t, ddReimport.TestId, 7)
+	assert.Equal(t, *ddReimport.File, "report.json")
+	assert.Equal(t, string(ddReimport.ScanType), "ZAP Scan")
+	assert.Equal(t, *ddReimport.DoNotReactivate, true)
+	assert.Assert(t, ddReimport.CloseOldFindingsProductScope == nil)
+
+	ddReimport.Id = 7
+	ddReimport.FileSha256 = "abc"
+	ddReimport.FindingsCreated = ref.Of(2)
+	populateResourceData(context.Background(), &diags, &terraformResource, ddResource)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, reimportResource.Id.ValueString(), "7")
+	assert.Equal(t, reimportResource.FileSha256.ValueString(), "abc")
+	assert.Equal(t, reimportResource.FindingsCreated.ValueInt64(), int64(2))
+	assert.Equal(t, reimportResource.FindingsClosed.IsNull(), true)
+}
+
+func TestScanReimportResourceTransportErrors(t *testing.T) {
+	report := filepath.Join(t.TempDir(), "report.json")
+	assert.NilError(t, os.WriteFile(report, []byte(`{}`), 0600))
+
+	ddResource := scanReimportDefectdojoResource{
+		ReImportScan: dd.ReImportScan{ScanType: "ZAP Scan", TestId: 7, File: &report},
+	}
+	client := unreachableClient(t)
+	// destroying a reimport makes no API call, so only the others can fail
+	_, _, err := ddResource.createApiCall(context.Background(), client)
+	assert.Assert(t, err != nil)
+	_, _, err = ddResource.readApiCall(context.Background(), client, 7)
+	assert.Assert(t, err != nil)
+	_, _, err = ddResource.updateApiCall(context.Background(), client, 7)
+	assert.Assert(t, err != nil)
+}
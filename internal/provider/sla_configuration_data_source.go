@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (t slaConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo SLA Configuration. You can specify either the `id` or the `name` to look up the SLA Configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SLA Configuration",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the SLA Configuration",
+				Computed:            true,
+			},
+			"critical": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a Critical Finding",
+				Computed:            true,
+			},
+			"high": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a High Finding",
+				Computed:            true,
+			},
+			"medium": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a Medium Finding",
+				Computed:            true,
+			},
+			"low": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a Low Finding",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type slaConfigurationDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d slaConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_configuration"
+}
+
+func NewSlaConfigurationDataSource() datasource.DataSource {
+	return &slaConfigurationDataSource{}
+}
+
+func (r *slaConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d slaConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// the data source exposes the same attributes as the resource
+	var data slaConfigurationResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var id int
+	if !data.Id.IsNull() {
+		idNumber, err := strconv.Atoi(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Resource",
+				"The id field could not be parsed into an integer")
+			return
+		}
+		id = idNumber
+	}
+
+	// the endpoint can't be filtered, so we go through all the pages and
+	// match the SLA Configurations ourselves
+	matches := []slaConfiguration{}
+	for offset := 0; ; offset += listPageSize {
+		var page slaConfigurationList
+		query := url.Values{
+			"limit":  []string{strconv.Itoa(listPageSize)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+		statusCode, body, err := rawApiCall(ctx, d.client, http.MethodGet, slaConfigurationsPath, query, nil, &page)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Retrieving Resource",
+				fmt.Sprintf("%s", err))
+			return
+		}
+
+		if statusCode != 200 {
+			resp.Diagnostics.AddError(
+				"API Error Retrieving Data Source",
+				fmt.Sprintf("Unexpected response code from API: %d", statusCode)+
+					fmt.Sprintf("\n\nbody:\n\n%+v", string(body)),
+			)
+			return
+		}
+
+		for _, sla := range page.Results {
+			if (data.Id.IsNull() || sla.Id == id) && (data.Name.IsNull() || sla.Name == data.Name.ValueString()) {
+				matches = append(matches, sla)
+			}
+		}
+
+		if page.Next == nil {
+			break
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			"No SLA Configurations matched the given parameters.")
+		return
+	} else if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Could not Retrieve Data Resource",
+			fmt.Sprintf("%d SLA Configurations matched the given parameters.", len(matches)))
+		return
+	}
+
+	var terraformResource terraformResourceData = &data
+	populateResourceData(ctx, &resp.Diagnostics, &terraformResource, &slaConfigurationDefectdojoResource{
+		slaConfiguration: matches[0],
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
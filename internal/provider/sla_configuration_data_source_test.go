@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSlaConfigurationNameDataSource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-sla-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSlaConfigurationDataSourceConfig(name, `name = defectdojo_sla_configuration.test.name`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_sla_configuration.test", "id", "defectdojo_sla_configuration.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_sla_configuration.test", "description", "test"),
+					resource.TestCheckResourceAttr("data.defectdojo_sla_configuration.test", "critical", "7"),
+					resource.TestCheckResourceAttr("data.defectdojo_sla_configuration.test", "low", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSlaConfigurationIdDataSource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-sla-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSlaConfigurationDataSourceConfig(name, `id = defectdojo_sla_configuration.test.id`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.defectdojo_sla_configuration.test", "name", name),
+					resource.TestCheckResourceAttr("data.defectdojo_sla_configuration.test", "high", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSlaConfigurationDataSourceNoMatch(t *testing.T) {
+	name := fmt.Sprintf("dox-test-sla-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No SLA Configurations matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_sla_configuration" "test" {
+  name = %q
+}
+`, name),
+			},
+		},
+	})
+}
+
+func testAccSlaConfigurationDataSourceConfig(name string, lookup string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_sla_configuration" "test" {
+  name = %[1]q
+  description = "test"
+  critical = 7
+  high = 30
+  medium = 90
+  low = 120
+}
+data "defectdojo_sla_configuration" "test" {
+  %[2]s
+}
+`, name, lookup)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t slaConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo SLA Configuration defines the number of days Findings of each severity have to be remediated in. Products are bound to one with their `sla_configuration_id`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SLA Configuration",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the SLA Configuration",
+				Optional:            true,
+			},
+			"critical": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a Critical Finding",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"high": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a High Finding",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"medium": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a Medium Finding",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"low": schema.Int64Attribute{
+				MarkdownDescription: "The number of days to remediate a Low Finding",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type slaConfigurationResourceData struct {
+	Name        types.String `tfsdk:"name" ddField:"Name"`
+	Description types.String `tfsdk:"description" ddField:"Description"`
+	Critical    types.Int64  `tfsdk:"critical" ddField:"Critical"`
+	High        types.Int64  `tfsdk:"high" ddField:"High"`
+	Medium      types.Int64  `tfsdk:"medium" ddField:"Medium"`
+	Low         types.Int64  `tfsdk:"low" ddField:"Low"`
+	Id          types.String `tfsdk:"id" ddField:"Id"`
+}
+
+// slaConfiguration is the SLA Configuration model of the API, which the
+// client doesn't know about.
+type slaConfiguration struct {
+	Id          int     `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Critical    int     `json:"critical"`
+	High        int     `json:"high"`
+	Medium      int     `json:"medium"`
+	Low         int     `json:"low"`
+}
+
+type slaConfigurationList struct {
+	Next    *string            `json:"next"`
+	Results []slaConfiguration `json:"results"`
+}
+
+const slaConfigurationsPath = "api/v2/sla_configurations/"
+
+type slaConfigurationDefectdojoResource struct {
+	slaConfiguration
+}
+
+func (ddr *slaConfigurationDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	var created slaConfiguration
+	statusCode, body, err := rawApiCall(ctx, client, http.MethodPost, slaConfigurationsPath, nil, ddr.slaConfiguration, &created)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode == 201 {
+		ddr.slaConfiguration = created
+	}
+
+	return statusCode, body, err
+}
+
+func (ddr *slaConfigurationDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	var read slaConfiguration
+	statusCode, body, err := rawApiCall(ctx, client, http.MethodGet, fmt.Sprintf("%s%d/", slaConfigurationsPath, idNumber), nil, nil, &read)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode == 200 {
+		ddr.slaConfiguration = read
+	}
+
+	return statusCode, body, err
+}
+
+func (ddr *slaConfigurationDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	var updated slaConfiguration
+	statusCode, body, err := rawApiCall(ctx, client, http.MethodPut, fmt.Sprintf("%s%d/", slaConfigurationsPath, idNumber), nil, ddr.slaConfiguration, &updated)
+	if err != nil {
+		return 0, nil, err
+	}
+	if statusCode == 200 {
+		ddr.slaConfiguration = updated
+	}
+
+	return statusCode, body, err
+}
+
+func (ddr *slaConfigurationDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	return rawApiCall(ctx, client, http.MethodDelete, fmt.Sprintf("%s%d/", slaConfigurationsPath, idNumber), nil, nil, nil)
+}
+
+type slaConfigurationResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &slaConfigurationResource{}
+var _ resource.ResourceWithImportState = &slaConfigurationResource{}
+
+func NewSlaConfigurationResource() resource.Resource {
+	return &slaConfigurationResource{
+		terraformResource: terraformResource{
+			dataProvider: slaConfigurationDataProvider{},
+		},
+	}
+}
+
+func (r slaConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sla_configuration"
+}
+
+type slaConfigurationDataProvider struct{}
+
+func (r slaConfigurationDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data slaConfigurationResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *slaConfigurationResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *slaConfigurationResourceData) defectdojoResource() defectdojoResource {
+	return &slaConfigurationDefectdojoResource{
+		slaConfiguration: slaConfiguration{},
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSlaConfigurationResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-sla-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSlaConfigurationResourceConfig(name, 7, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "name", name),
+					resource.TestCheckNoResourceAttr("defectdojo_sla_configuration.test", "description"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "critical", "7"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "high", "30"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "medium", "90"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "low", "120"),
+					resource.TestCheckResourceAttrPair("defectdojo_product.test", "sla_configuration_id", "defectdojo_sla_configuration.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_sla_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSlaConfigurationResourceUpdateConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "description", "Internet facing products"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "critical", "3"),
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "high", "14"),
+					resource.TestCheckResourceAttrPair("defectdojo_product.test", "sla_configuration_id", "defectdojo_sla_configuration.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSlaConfigurationResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSlaConfigurationOnlyConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_sla_configuration.test", "name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccSlaConfigurationOnlyConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_sla_configuration.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlaConfigurationOnlyConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_sla_configuration" "test" {
+  name = %q
+  critical = 7
+  high = 30
+  medium = 90
+  low = 120
+}
+`, name)
+}
+
+func testAccSlaConfigurationResourceConfig(name string, critical int, high int) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_sla_configuration" "test" {
+  name = %[1]q
+  critical = %[2]d
+  high = %[3]d
+  medium = 90
+  low = 120
+}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+  sla_configuration_id = defectdojo_sla_configuration.test.id
+}
+`, name, critical, high)
+}
+
+func testAccSlaConfigurationResourceUpdateConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_sla_configuration" "test" {
+  name = %[1]q
+  description = "Internet facing products"
+  critical = 3
+  high = 14
+  medium = 90
+  low = 120
+}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+  sla_configuration_id = defectdojo_sla_configuration.test.id
+}
+`, name)
+}
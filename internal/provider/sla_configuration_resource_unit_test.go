@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestSlaConfigurationResource__defectdojoResource(t *testing.T) {
+	slaConfigurationResource := slaConfigurationResourceData{
+		Name:        types.StringValue("Internet facing"),
+		Description: types.StringNull(),
+		Critical:    types.Int64Value(7),
+		High:        types.Int64Value(30),
+		Medium:      types.Int64Value(90),
+		Low:         types.Int64Value(120),
+	}
+	var terraformResource terraformResourceData = &slaConfigurationResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddSlaConfiguration := ddResource.(*slaConfigurationDefectdojoResource)
+	assert.Equal(t, ddSlaConfiguration.Name, "Internet facing")
+	assert.Assert(t, ddSlaConfiguration.Description == nil)
+	assert.Equal(t, ddSlaConfiguration.Critical, 7)
+	assert.Equal(t, ddSlaConfiguration.High, 30)
+	assert.Equal(t, ddSlaConfiguration.Medium, 90)
+	assert.Equal(t, ddSlaConfiguration.Low, 120)
+}
+
+func TestSlaConfigurationResourcePopulate(t *testing.T) {
+	ddSlaConfiguration := slaConfigurationDefectdojoResource{
+		slaConfiguration: slaConfiguration{
+			Id:          42,
+			Name:        "Internet facing",
+			Description: ref.Of("Products reachable from the internet"),
+			Critical:    7,
+			High:        30,
+			Medium:      90,
+			Low:         120,
+		},
+	}
+
+	slaConfigurationResource := slaConfigurationResourceData{}
+	var terraformResource terraformResourceData = &slaConfigurationResource
+
+	diags := diag.Diagnostics{}
+	populateResourceData(context.Background(), &diags, &terraformResource, &ddSlaConfiguration)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, slaConfigurationResource.Id.ValueString(), "42")
+	assert.Equal(t, slaConfigurationResource.Name.ValueString(), "Internet facing")
+	assert.Equal(t, slaConfigurationResource.Description.ValueString(), "Products reachable from the internet")
+	assert.Equal(t, slaConfigurationResource.Critical.ValueInt64(), int64(7))
+	assert.Equal(t, slaConfigurationResource.Low.ValueInt64(), int64(120))
+}
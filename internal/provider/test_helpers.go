@@ -46,6 +46,116 @@ func testAccDeleteResourceOutsideTerraform(resourceName string) resource.TestChe
 			if err != nil {
 				return err
 			}
+		} else if match, err := regexp.MatchString(`^defectdojo_engagement\.`, resourceName); err == nil && match {
+			resp, err = client.EngagementsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_test\.`, resourceName); err == nil && match {
+			resp, err = client.TestsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_user\.`, resourceName); err == nil && match {
+			resp, err = client.UsersDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_dojo_group\.`, resourceName); err == nil && match {
+			resp, err = client.DojoGroupsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_dojo_group_member\.`, resourceName); err == nil && match {
+			resp, err = client.DojoGroupMembersDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_product_member\.`, resourceName); err == nil && match {
+			resp, err = client.ProductMembersDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_product_group\.`, resourceName); err == nil && match {
+			resp, err = client.ProductGroupsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_product_type_member\.`, resourceName); err == nil && match {
+			resp, err = client.ProductTypeMembersDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_product_type_group\.`, resourceName); err == nil && match {
+			resp, err = client.ProductTypeGroupsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_jira_instance\.`, resourceName); err == nil && match {
+			resp, err = client.JiraInstancesDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_jira_finding_mapping\.`, resourceName); err == nil && match {
+			resp, err = client.JiraFindingMappingsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_regulation\.`, resourceName); err == nil && match {
+			resp, err = client.RegulationsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_development_environment\.`, resourceName); err == nil && match {
+			resp, err = client.DevelopmentEnvironmentsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_tool_type\.`, resourceName); err == nil && match {
+			resp, err = client.ToolTypesDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_tool_configuration\.`, resourceName); err == nil && match {
+			resp, err = client.ToolConfigurationsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_tool_product_settings\.`, resourceName); err == nil && match {
+			resp, err = client.ToolProductSettingsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_product_api_scan_configuration\.`, resourceName); err == nil && match {
+			resp, err = client.ProductApiScanConfigurationsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_finding\.`, resourceName); err == nil && match {
+			resp, err = client.FindingsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_finding_triage\.`, resourceName); err == nil && match {
+			// the triage is gone along with its Finding
+			resp, err = client.FindingsDestroy(context.Background(), i)
+			if err != nil {
+				return err
+			}
+		} else if match, err := regexp.MatchString(`^defectdojo_sla_configuration\.`, resourceName); err == nil && match {
+			// the client doesn't know about SLA configurations
+			statusCode, _, err := rawApiCall(context.Background(), client, http.MethodDelete, fmt.Sprintf("%s%d/", slaConfigurationsPath, i), nil, nil, nil)
+			if err != nil {
+				return err
+			}
+			resp = &http.Response{StatusCode: statusCode}
+		} else if match, err := regexp.MatchString(`^defectdojo_risk_acceptance\.`, resourceName); err == nil && match {
+			// the client doesn't know about risk acceptance operations
+			statusCode, _, err := rawApiCall(context.Background(), client, http.MethodDelete, fmt.Sprintf("%s%d/", riskAcceptancesPath, i), nil, nil, nil)
+			if err != nil {
+				return err
+			}
+			resp = &http.Response{StatusCode: statusCode}
 		} else {
 			return fmt.Errorf("Unknown type: %s, %s", resourceName, err)
 		}
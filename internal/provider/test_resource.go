@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// utcTimestampRegexp matches RFC3339 timestamps in UTC and without fractional seconds, which is how
+// the API returns them. Any other form would be read back differently than it was configured.
+var utcTimestampRegexp = regexp.MustCompile(`\A\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z\z`)
+
+func (t testResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Test. A Test belongs to an Engagement and holds the Findings of one scan type.",
+
+		Attributes: map[string]schema.Attribute{
+			"engagement_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Engagement this Test belongs to",
+				Required:            true,
+			},
+			"test_type_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Test Type",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the Test",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Test",
+				Optional:            true,
+			},
+			"target_start": schema.StringAttribute{
+				MarkdownDescription: "The time the Test is planned to start, in RFC3339 format and in UTC (e.g. `2023-01-01T00:00:00Z`)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utcTimestampRegexp, "Must be a timestamp in RFC3339 format, in UTC"),
+				},
+			},
+			"target_end": schema.StringAttribute{
+				MarkdownDescription: "The time the Test is planned to end, in RFC3339 format and in UTC (e.g. `2023-01-31T00:00:00Z`)",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utcTimestampRegexp, "Must be a timestamp in RFC3339 format, in UTC"),
+				},
+			},
+			"environment_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Development Environment that was tested",
+				Optional:            true,
+			},
+			"lead_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the user who leads this Test",
+				Optional:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version that was tested. A reimport may update this field.",
+				Optional:            true,
+			},
+			"branch_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag or branch that was tested. A reimport may update this field.",
+				Optional:            true,
+			},
+			"build_id": schema.StringAttribute{
+				MarkdownDescription: "Build ID that was tested. A reimport may update this field.",
+				Optional:            true,
+			},
+			"commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Commit hash that was tested. A reimport may update this field.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply to the Test",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`\A[a-z]+\z`), "Tags must be lower case values"),
+					),
+				},
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type testResourceData struct {
+	EngagementId  types.Int64  `tfsdk:"engagement_id" ddField:"Engagement"`
+	TestTypeId    types.Int64  `tfsdk:"test_type_id" ddField:"TestType"`
+	Title         types.String `tfsdk:"title" ddField:"Title"`
+	Description   types.String `tfsdk:"description" ddField:"Description"`
+	TargetStart   types.String `tfsdk:"target_start" ddField:"TargetStart"`
+	TargetEnd     types.String `tfsdk:"target_end" ddField:"TargetEnd"`
+	EnvironmentId types.Int64  `tfsdk:"environment_id" ddField:"Environment"`
+	LeadId        types.Int64  `tfsdk:"lead_id" ddField:"Lead"`
+	Version       types.String `tfsdk:"version" ddField:"Version"`
+	BranchTag     types.String `tfsdk:"branch_tag" ddField:"BranchTag"`
+	BuildId       types.String `tfsdk:"build_id" ddField:"BuildId"`
+	CommitHash    types.String `tfsdk:"commit_hash" ddField:"CommitHash"`
+	Tags          types.Set    `tfsdk:"tags" ddField:"Tags"`
+	Id            types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type testDefectdojoResource struct {
+	dd.Test
+}
+
+func (ddr *testDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	// the create endpoint takes (and returns) a TestCreate rather than a Test, so copy across
+	// the fields that we manage
+	reqBody := dd.TestsCreateJSONRequestBody{
+		Engagement:  ddr.Engagement,
+		TestType:    ddr.TestType,
+		Title:       ddr.Title,
+		Description: ddr.Description,
+		TargetStart: ddr.TargetStart,
+		TargetEnd:   ddr.TargetEnd,
+		Environment: ddr.Environment,
+		Lead:        ddr.Lead,
+		Version:     ddr.Version,
+		BranchTag:   ddr.BranchTag,
+		BuildId:     ddr.BuildId,
+		CommitHash:  ddr.CommitHash,
+		Tags:        ddr.Tags,
+		Files:       []int{},
+	}
+	apiResp, err := client.TestsCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		created := apiResp.JSON201
+		ddr.Test = dd.Test{
+			Id:          created.Id,
+			Engagement:  created.Engagement,
+			TestType:    created.TestType,
+			Title:       created.Title,
+			Description: created.Description,
+			TargetStart: created.TargetStart,
+			TargetEnd:   created.TargetEnd,
+			Environment: created.Environment,
+			Lead:        created.Lead,
+			Version:     created.Version,
+			BranchTag:   created.BranchTag,
+			BuildId:     created.BuildId,
+			CommitHash:  created.CommitHash,
+			Tags:        created.Tags,
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *testDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.TestsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.Test = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *testDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.TestsUpdateJSONRequestBody(ddr.Test)
+	apiResp, err := client.TestsUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.Test = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *testDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.TestsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type testResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &testResource{}
+var _ resource.ResourceWithImportState = &testResource{}
+
+func NewTestResource() resource.Resource {
+	return &testResource{
+		terraformResource: terraformResource{
+			dataProvider: testDataProvider{},
+		},
+	}
+}
+
+func (r testResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test"
+}
+
+type testDataProvider struct{}
+
+func (r testDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data testResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *testResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *testResourceData) defectdojoResource() defectdojoResource {
+	return &testDefectdojoResource{
+		Test: dd.Test{},
+	}
+}
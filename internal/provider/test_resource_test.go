@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTestResource(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-test-test-%s", resource.UniqueId())
+	updatedTitle := fmt.Sprintf("dox-new-test-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTestResourceConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_test.test", "title", title),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "description", "test"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "test_type_id", "1"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "target_start", "2023-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "target_end", "2023-01-31T00:00:00Z"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "version", "1.0.0"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "branch_tag", "main"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "build_id", "42"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "commit_hash", "abc123"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "tags.0", "bar"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "tags.1", "foo"),
+					resource.TestCheckResourceAttrPair("defectdojo_test.test", "engagement_id", "defectdojo_engagement.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_test.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTestResourceMinimalConfig(productName, updatedTitle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_test.test", "title", updatedTitle),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "target_start", "2023-02-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "target_end", "2023-02-28T00:00:00Z"),
+					resource.TestCheckResourceAttr("defectdojo_test.test", "tags.#", "0"),
+					resource.TestCheckNoResourceAttr("defectdojo_test.test", "description"),
+					resource.TestCheckNoResourceAttr("defectdojo_test.test", "version"),
+					resource.TestCheckNoResourceAttr("defectdojo_test.test", "branch_tag"),
+					resource.TestCheckNoResourceAttr("defectdojo_test.test", "build_id"),
+					resource.TestCheckNoResourceAttr("defectdojo_test.test", "commit_hash"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTestResourceDeleteDrift(t *testing.T) {
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	title := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTestResourceMinimalConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_test.test", "title", title),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccTestResourceMinimalConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_test.test"),
+				),
+			},
+			{
+				Config: testAccTestResourceMinimalConfig(productName, title),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_test.test", "title", title),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTestResourceConfig(productName string, title string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+}
+resource "defectdojo_test" "test" {
+  engagement_id = defectdojo_engagement.test.id
+  test_type_id = 1
+  title = %[2]q
+  description = "test"
+  target_start = "2023-01-01T00:00:00Z"
+  target_end = "2023-01-31T00:00:00Z"
+  version = "1.0.0"
+  branch_tag = "main"
+  build_id = "42"
+  commit_hash = "abc123"
+  tags = ["foo", "bar"]
+}
+`, productName, title)
+}
+
+func testAccTestResourceMinimalConfig(productName string, title string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-12-31"
+}
+resource "defectdojo_test" "test" {
+  engagement_id = defectdojo_engagement.test.id
+  test_type_id = 1
+  title = %[2]q
+  target_start = "2023-02-01T00:00:00Z"
+  target_end = "2023-02-28T00:00:00Z"
+}
+`, productName, title)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestTestResourcePopulate(t *testing.T) {
+	expectedTitle := "A Test"
+	expectedEnvironmentId := 3
+
+	ddTest := testDefectdojoResource{
+		Test: dd.Test{
+			Id:          99,
+			Engagement:  42,
+			TestType:    1,
+			Title:       &expectedTitle,
+			TargetStart: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+			TargetEnd:   time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			Environment: &expectedEnvironmentId,
+		},
+	}
+
+	testResource := testResourceData{}
+	var terraformResource terraformResourceData = &testResource
+
+	populateResourceData(context.Background(), &diag.Diagnostics{}, &terraformResource, &ddTest)
+	assert.Equal(t, testResource.Id.ValueString(), "99")
+	assert.Equal(t, testResource.EngagementId.ValueInt64(), (int64)(42))
+	assert.Equal(t, testResource.TestTypeId.ValueInt64(), (int64)(1))
+	assert.Equal(t, testResource.Title.ValueString(), expectedTitle)
+	assert.Equal(t, testResource.TargetStart.ValueString(), "2023-01-02T03:04:05Z")
+	assert.Equal(t, testResource.TargetEnd.ValueString(), "2023-01-31T00:00:00Z")
+	assert.Equal(t, testResource.EnvironmentId.ValueInt64(), (int64)(expectedEnvironmentId))
+	assert.Equal(t, testResource.LeadId.IsNull(), true)
+	assert.Equal(t, testResource.Version.IsNull(), true)
+}
+
+func TestTestResource__defectdojoResource(t *testing.T) {
+	testResource := testResourceData{
+		EngagementId:  types.Int64Value(42),
+		TestTypeId:    types.Int64Value(1),
+		Title:         types.StringValue("A Test"),
+		TargetStart:   types.StringValue("2023-01-02T03:04:05Z"),
+		TargetEnd:     types.StringValue("2023-01-31T00:00:00-05:00"),
+		EnvironmentId: types.Int64Null(),
+		Tags:          types.SetNull(types.StringType),
+	}
+
+	ddResource := testResource.defectdojoResource()
+	ddTest := ddResource.(*testDefectdojoResource)
+	var terraformResource terraformResourceData = &testResource
+	diags := diag.Diagnostics{}
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+
+	var nilInt *int
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, ddTest.Engagement, 42)
+	assert.Equal(t, ddTest.TestType, 1)
+	assert.Equal(t, *ddTest.Title, "A Test")
+	assert.Equal(t, ddTest.TargetStart.Equal(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)), true)
+	assert.Equal(t, ddTest.TargetEnd.Equal(time.Date(2023, 1, 31, 5, 0, 0, 0, time.UTC)), true)
+	assert.Equal(t, ddTest.Environment, nilInt)
+}
+
+func TestTestResource__utcTimestampRegexp(t *testing.T) {
+	assert.Equal(t, utcTimestampRegexp.MatchString("2023-01-31T00:00:00Z"), true)
+	// these would be read back from the API as a different string
+	assert.Equal(t, utcTimestampRegexp.MatchString("2023-01-31T00:00:00-05:00"), false)
+	assert.Equal(t, utcTimestampRegexp.MatchString("2023-01-31T00:00:00.5Z"), false)
+	assert.Equal(t, utcTimestampRegexp.MatchString("2023-01-31"), false)
+}
+
+func TestTestResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &testDefectdojoResource{})
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t testTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo Test Type, looked up by its `name`. The `id` can be used as the `test_type_id` of a `defectdojo_test`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Test Type",
+				Required:            true,
+			},
+			"static_tool": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Test Type is a static analysis tool",
+				Computed:            true,
+			},
+			"dynamic_tool": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Test Type is a dynamic analysis tool",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Test Type can be selected for new Tests",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags applied to the Test Type",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type testTypeDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d testTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_type"
+}
+
+func NewTestTypeDataSource() datasource.DataSource {
+	return &testTypeDataSource{}
+}
+
+func (r *testTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d testTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// the data source exposes the same attributes as the resource
+	var data testTypeResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.TestTypesListWithResponse(ctx, &dd.TestTypesListParams{
+		Name: ref.Of(data.Name.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+
+	if apiResp.StatusCode() == 200 {
+		if *apiResp.JSON200.Count == 0 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				"No Test Types matched the given parameters.")
+			return
+		} else if *apiResp.JSON200.Count > 1 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				fmt.Sprintf("%d Test Types matched the given parameters.\n\nResponse:\n\n%s", *apiResp.JSON200.Count, apiResp.Body))
+			return
+		}
+
+		var terraformResource terraformResourceData = &data
+		populateResourceData(ctx, &resp.Diagnostics, &terraformResource, &testTypeDefectdojoResource{
+			TestType: (*apiResp.JSON200.Results)[0],
+		})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"API Error Retrieving Data Source",
+			fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+				fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTestTypeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+provider "defectdojo" {}
+data "defectdojo_test_type" "test" {
+  name = "ZAP Scan"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.defectdojo_test_type.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_test_type.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTestTypeDataSourceNoMatch(t *testing.T) {
+	name := fmt.Sprintf("dox-test-type-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Test Types matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_test_type" "test" {
+  name = %q
+}
+`, name),
+			},
+		},
+	})
+}
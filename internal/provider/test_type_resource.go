@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t testTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Test Type, for example the scan type of a custom parser. Its `id` can be used as the `test_type_id` of a `defectdojo_test`. DefectDojo does not allow Test Types to be deleted, so destroying this resource deactivates the Test Type instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Test Type",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"static_tool": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Test Type is a static analysis tool",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dynamic_tool": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Test Type is a dynamic analysis tool",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Test Type can be selected for new Tests. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(true),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply to the Test Type",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type testTypeResourceData struct {
+	Name        types.String `tfsdk:"name" ddField:"Name"`
+	StaticTool  types.Bool   `tfsdk:"static_tool" ddField:"StaticTool"`
+	DynamicTool types.Bool   `tfsdk:"dynamic_tool" ddField:"DynamicTool"`
+	Active      types.Bool   `tfsdk:"active" ddField:"Active"`
+	Tags        types.Set    `tfsdk:"tags" ddField:"Tags"`
+	Id          types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type testTypeDefectdojoResource struct {
+	dd.TestType
+}
+
+func (ddr *testTypeDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.TestTypesCreateJSONRequestBody(ddr.TestType)
+	apiResp, err := client.TestTypesCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.TestType = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *testTypeDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.TestTypesRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.TestType = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *testTypeDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.TestTypesUpdateJSONRequestBody(ddr.TestType)
+	apiResp, err := client.TestTypesUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.TestType = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *testTypeDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// the API has no endpoint to delete test types, so the best we can do is
+	// to deactivate it so that it can't be picked for new tests anymore
+	apiResp, err := client.TestTypesPartialUpdateWithResponse(ctx, idNumber, dd.TestTypesPartialUpdateJSONRequestBody{
+		Active: ref.Of(false),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.StatusCode() != 200 {
+		return apiResp.StatusCode(), apiResp.Body, err
+	}
+	return 204, nil, nil
+}
+
+type testTypeResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &testTypeResource{}
+var _ resource.ResourceWithImportState = &testTypeResource{}
+
+func NewTestTypeResource() resource.Resource {
+	return &testTypeResource{
+		terraformResource: terraformResource{
+			dataProvider: testTypeDataProvider{},
+		},
+	}
+}
+
+func (r testTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_type"
+}
+
+type testTypeDataProvider struct{}
+
+func (r testTypeDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data testTypeResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *testTypeResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *testTypeResourceData) defectdojoResource() defectdojoResource {
+	return &testTypeDefectdojoResource{
+		TestType: dd.TestType{},
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTestTypeResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-type-%s", resource.UniqueId())
+	productName := fmt.Sprintf("dox-test-repo-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTestTypeResourceConfig(name, productName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "name", name),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "static_tool", "true"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "dynamic_tool", "false"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "active", "true"),
+					resource.TestCheckNoResourceAttr("defectdojo_test_type.test", "tags"),
+					resource.TestCheckResourceAttrPair("defectdojo_test.test", "test_type_id", "defectdojo_test_type.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_test.test", "environment_id", "defectdojo_development_environment.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_test_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTestTypeResourceUpdateConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "static_tool", "false"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "dynamic_tool", "true"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("defectdojo_test_type.test", "tags.0", "custom"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTestTypeResourceConfig(name string, productName string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_test_type" "test" {
+  name = %[1]q
+  static_tool = true
+}
+resource "defectdojo_development_environment" "test" {
+  name = %[1]q
+}
+resource "defectdojo_product" "test" {
+  name = %[2]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_engagement" "test" {
+  name = %[2]q
+  product_id = defectdojo_product.test.id
+  target_start = "2023-01-01"
+  target_end = "2023-01-31"
+}
+resource "defectdojo_test" "test" {
+  title = %[1]q
+  engagement_id = defectdojo_engagement.test.id
+  test_type_id = defectdojo_test_type.test.id
+  environment_id = defectdojo_development_environment.test.id
+  target_start = "2023-01-01T00:00:00Z"
+  target_end = "2023-01-31T00:00:00Z"
+}
+`, name, productName)
+}
+
+func testAccTestTypeResourceUpdateConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_test_type" "test" {
+  name = %q
+  static_tool = false
+  dynamic_tool = true
+  tags = ["custom"]
+}
+`, name)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestTestTypeResource__defectdojoResource(t *testing.T) {
+	testTypeResource := testTypeResourceData{
+		Name:        types.StringValue("Custom Parser Scan"),
+		StaticTool:  types.BoolValue(true),
+		DynamicTool: types.BoolUnknown(),
+		Active:      types.BoolUnknown(),
+		Tags: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("custom"),
+		}),
+	}
+	var terraformResource terraformResourceData = &testTypeResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddTestType := ddResource.(*testTypeDefectdojoResource)
+	assert.Equal(t, ddTestType.Name, "Custom Parser Scan")
+	assert.Equal(t, *ddTestType.StaticTool, true)
+	assert.Assert(t, ddTestType.DynamicTool == nil)
+	assert.Assert(t, ddTestType.Active == nil)
+	assert.DeepEqual(t, *ddTestType.Tags, []string{"custom"})
+
+	body, err := json.Marshal(ddTestType.TestType)
+	assert.NilError(t, err)
+	var sent map[string]interface{}
+	assert.NilError(t, json.Unmarshal(body, &sent))
+	_, ok := sent["dynamic_tool"]
+	assert.Equal(t, ok, false)
+	_, ok = sent["active"]
+	assert.Equal(t, ok, false)
+}
+
+func TestTestTypeResourceActiveDefault(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	(&testTypeResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	active := schemaResp.Schema.Attributes["active"].(schema.BoolAttribute)
+	activeResp := planmodifier.BoolResponse{PlanValue: types.BoolUnknown()}
+	for _, modifier := range active.PlanModifiers {
+		modifier.PlanModifyBool(ctx, planmodifier.BoolRequest{ConfigValue: types.BoolNull(), PlanValue: types.BoolUnknown()}, &activeResp)
+	}
+	assert.Equal(t, activeResp.PlanValue.ValueBool(), true)
+}
+
+func TestTestTypeResourcePopulate(t *testing.T) {
+	ddTestType := testTypeDefectdojoResource{
+		TestType: dd.TestType{
+			Id:          42,
+			Name:        "Custom Parser Scan",
+			StaticTool:  ref.Of(false),
+			DynamicTool: ref.Of(true),
+			Active:      ref.Of(true),
+			Tags:        &[]string{},
+		},
+	}
+
+	testTypeResource := testTypeResourceData{
+		Tags: types.SetNull(types.StringType),
+	}
+	var terraformResource terraformResourceData = &testTypeResource
+
+	diags := diag.Diagnostics{}
+	populateResourceData(context.Background(), &diags, &terraformResource, &ddTestType)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, testTypeResource.Id.ValueString(), "42")
+	assert.Equal(t, testTypeResource.Name.ValueString(), "Custom Parser Scan")
+	assert.Equal(t, testTypeResource.StaticTool.ValueBool(), false)
+	assert.Equal(t, testTypeResource.DynamicTool.ValueBool(), true)
+	assert.Equal(t, testTypeResource.Active.ValueBool(), true)
+	// no tags in the API stays null in terraform
+	assert.Equal(t, testTypeResource.Tags.IsNull(), true)
+}
+
+func TestTestTypeResourceDeleteDeactivates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPatch)
+		assert.Equal(t, r.URL.Path, "/api/v2/test_types/42/")
+
+		body, err := io.ReadAll(r.Body)
+		assert.NilError(t, err)
+		var patch map[string]interface{}
+		assert.NilError(t, json.Unmarshal(body, &patch))
+		assert.DeepEqual(t, patch, map[string]interface{}{"active": false})
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 42, "name": "Custom Parser Scan", "active": false}`))
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddTestType := testTypeDefectdojoResource{}
+	statusCode, _, err := ddTestType.deleteApiCall(context.Background(), client, 42)
+	assert.NilError(t, err)
+	assert.Equal(t, statusCode, 204)
+}
+
+func TestTestTypeResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &testTypeDefectdojoResource{})
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t toolConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Tool Configuration holds the connection details of an external tool, such as a SonarQube server. The `password`, `api_key` and `ssh` are never read back from the API, so changes made to them outside of Terraform are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Tool Configuration",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Tool Configuration",
+				Optional:            true,
+			},
+			"tool_type_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Tool Type",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the tool",
+				Optional:            true,
+			},
+			"authentication_type": schema.StringAttribute{
+				MarkdownDescription: "How to authenticate to the tool. Valid values are: 'API', 'Password', 'SSH'",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("API", "Password", "SSH"),
+				},
+			},
+			"auth_title": schema.StringAttribute{
+				MarkdownDescription: "The title of the SSH or API key",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate to the tool",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to authenticate to the tool",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The API key used to authenticate to the tool",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ssh": schema.StringAttribute{
+				MarkdownDescription: "The SSH private key used to authenticate to the tool",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"extras": schema.StringAttribute{
+				MarkdownDescription: "Additional definitions that will be consumed by the scanner",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type toolConfigurationResourceData struct {
+	Name               types.String `tfsdk:"name" ddField:"Name"`
+	Description        types.String `tfsdk:"description" ddField:"Description"`
+	ToolTypeId         types.Int64  `tfsdk:"tool_type_id" ddField:"ToolType"`
+	Url                types.String `tfsdk:"url" ddField:"Url"`
+	AuthenticationType types.String `tfsdk:"authentication_type" ddField:"AuthenticationType"`
+	AuthTitle          types.String `tfsdk:"auth_title" ddField:"AuthTitle"`
+	Username           types.String `tfsdk:"username" ddField:"Username"`
+	Password           types.String `tfsdk:"password" ddField:"Password"`
+	ApiKey             types.String `tfsdk:"api_key" ddField:"ApiKey"`
+	Ssh                types.String `tfsdk:"ssh" ddField:"Ssh"`
+	Extras             types.String `tfsdk:"extras" ddField:"Extras"`
+	Id                 types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type toolConfigurationDefectdojoResource struct {
+	dd.ToolConfiguration
+	// the secrets are kept apart from the configuration and only ever come
+	// from the terraform configuration or state
+	Password *string
+	ApiKey   *string
+	Ssh      *string
+}
+
+func (ddr *toolConfigurationDefectdojoResource) requestBody() dd.ToolConfiguration {
+	configuration := ddr.ToolConfiguration
+	configuration.Password = ddr.Password
+	configuration.ApiKey = ddr.ApiKey
+	configuration.Ssh = ddr.Ssh
+	return configuration
+}
+
+func (ddr *toolConfigurationDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.ToolConfigurationsCreateJSONRequestBody(ddr.requestBody())
+	apiResp, err := client.ToolConfigurationsCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.ToolConfiguration = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolConfigurationDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ToolConfigurationsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ToolConfiguration = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolConfigurationDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.ToolConfigurationsUpdateJSONRequestBody(ddr.requestBody())
+	apiResp, err := client.ToolConfigurationsUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ToolConfiguration = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolConfigurationDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ToolConfigurationsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type toolConfigurationResource struct {
+	terraformResource
+}
+
+var _ resource.Resource = &toolConfigurationResource{}
+var _ resource.ResourceWithImportState = &toolConfigurationResource{}
+
+func NewToolConfigurationResource() resource.Resource {
+	return &toolConfigurationResource{
+		terraformResource: terraformResource{
+			dataProvider: toolConfigurationDataProvider{},
+		},
+	}
+}
+
+func (r toolConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_configuration"
+}
+
+type toolConfigurationDataProvider struct{}
+
+func (r toolConfigurationDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data toolConfigurationResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *toolConfigurationResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *toolConfigurationResourceData) defectdojoResource() defectdojoResource {
+	return &toolConfigurationDefectdojoResource{
+		ToolConfiguration: dd.ToolConfiguration{},
+	}
+}
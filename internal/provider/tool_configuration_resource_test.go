@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccToolConfigurationResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-tool-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccToolConfigurationResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "name", name),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "url", "https://sonarqube.example.com"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "authentication_type", "Password"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "username", "dojo"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "password", "secret"),
+					resource.TestCheckNoResourceAttr("defectdojo_tool_configuration.test", "api_key"),
+					resource.TestCheckResourceAttrPair("defectdojo_tool_configuration.test", "tool_type_id", "defectdojo_tool_type.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_tool_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the secrets are never read back from the API
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccToolConfigurationResourceUpdateConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "authentication_type", "API"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "api_key", "api-secret"),
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "extras", "org=doximity"),
+					resource.TestCheckNoResourceAttr("defectdojo_tool_configuration.test", "username"),
+					resource.TestCheckNoResourceAttr("defectdojo_tool_configuration.test", "password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccToolConfigurationResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccToolConfigurationResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_tool_configuration.test", "name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccToolConfigurationResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_tool_configuration.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccToolConfigurationResourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_tool_type" "test" {
+  name = %[1]q
+}
+resource "defectdojo_tool_configuration" "test" {
+  name = %[1]q
+  tool_type_id = defectdojo_tool_type.test.id
+  url = "https://sonarqube.example.com"
+  authentication_type = "Password"
+  username = "dojo"
+  password = "secret"
+}
+`, name)
+}
+
+func testAccToolConfigurationResourceUpdateConfig(name string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_tool_type" "test" {
+  name = %[1]q
+}
+resource "defectdojo_tool_configuration" "test" {
+  name = %[1]q
+  tool_type_id = defectdojo_tool_type.test.id
+  url = "https://sonarqube.example.com"
+  authentication_type = "API"
+  api_key = "api-secret"
+  extras = "org=doximity"
+}
+`, name)
+}
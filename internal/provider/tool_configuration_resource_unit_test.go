@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/assert"
+)
+
+func TestToolConfigurationResource__defectdojoResource(t *testing.T) {
+	toolConfigurationResource := toolConfigurationResourceData{
+		Name:               types.StringValue("SonarQube"),
+		ToolTypeId:         types.Int64Value(3),
+		Url:                types.StringValue("https://sonarqube.example.com"),
+		AuthenticationType: types.StringValue("API"),
+		Password:           types.StringNull(),
+		ApiKey:             types.StringValue("api-secret"),
+		Ssh:                types.StringNull(),
+	}
+	var terraformResource terraformResourceData = &toolConfigurationResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddToolConfiguration := ddResource.(*toolConfigurationDefectdojoResource)
+	assert.Equal(t, ddToolConfiguration.Name, "SonarQube")
+	assert.Equal(t, ddToolConfiguration.ToolType, 3)
+	assert.Equal(t, *ddToolConfiguration.AuthenticationType, dd.ToolConfigurationAuthenticationTypeAPI)
+
+	reqBody := ddToolConfiguration.requestBody()
+	assert.Equal(t, *reqBody.ApiKey, "api-secret")
+	assert.Assert(t, reqBody.Password == nil)
+	assert.Assert(t, reqBody.Ssh == nil)
+}
+
+func TestToolConfigurationResourcePopulate(t *testing.T) {
+	ddToolConfiguration := toolConfigurationDefectdojoResource{
+		ToolConfiguration: dd.ToolConfiguration{
+			Id:       42,
+			Name:     "SonarQube",
+			ToolType: 3,
+			Username: ref.Of("dojo"),
+			Password: ref.Of("from the api"),
+			ApiKey:   ref.Of("from the api"),
+			Ssh:      ref.Of("from the api"),
+		},
+		Password: ref.Of("secret"),
+	}
+
+	toolConfigurationResource := toolConfigurationResourceData{}
+	var terraformResource terraformResourceData = &toolConfigurationResource
+
+	diags := diag.Diagnostics{}
+	populateResourceData(context.Background(), &diags, &terraformResource, &ddToolConfiguration)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, toolConfigurationResource.Id.ValueString(), "42")
+	assert.Equal(t, toolConfigurationResource.ToolTypeId.ValueInt64(), int64(3))
+	assert.Equal(t, toolConfigurationResource.Username.ValueString(), "dojo")
+	// the secrets are never taken from the API response
+	assert.Equal(t, toolConfigurationResource.Password.ValueString(), "secret")
+	assert.Equal(t, toolConfigurationResource.ApiKey.IsNull(), true)
+	assert.Equal(t, toolConfigurationResource.Ssh.IsNull(), true)
+}
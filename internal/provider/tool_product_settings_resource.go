@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t toolProductSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo Tool Product Settings bind a Tool Configuration to a Product, for example to tell which SonarQube project holds the findings of the Product.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Tool Product Settings",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Tool Product Settings",
+				Optional:            true,
+			},
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Product",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tool_configuration_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Tool Configuration",
+				Required:            true,
+			},
+			"tool_project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in the tool",
+				Optional:            true,
+			},
+			"setting_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the settings in the tool",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the project in the tool",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type toolProductSettingsResourceData struct {
+	Name                types.String `tfsdk:"name" ddField:"Name"`
+	Description         types.String `tfsdk:"description" ddField:"Description"`
+	ProductId           types.Int64  `tfsdk:"product_id" ddField:"Product"`
+	ToolConfigurationId types.Int64  `tfsdk:"tool_configuration_id" ddField:"ToolConfiguration"`
+	ToolProjectId       types.String `tfsdk:"tool_project_id" ddField:"ToolProjectId"`
+	SettingUrl          types.String `tfsdk:"setting_url" ddField:"SettingUrl"`
+	Url                 types.String `tfsdk:"url" ddField:"Url"`
+	Id                  types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type toolProductSettingsDefectdojoResource struct {
+	dd.ToolProductSettings
+}
+
+func (ddr *toolProductSettingsDefectdojoResource) requestBody() dd.ToolProductSettings {
+	settings := ddr.ToolProductSettings
+	// the API refuses a null list of notes
+	if settings.Notes == nil {
+		settings.Notes = []int{}
+	}
+	return settings
+}
+
+func (ddr *toolProductSettingsDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.ToolProductSettingsCreateJSONRequestBody(ddr.requestBody())
+	apiResp, err := client.ToolProductSettingsCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.ToolProductSettings = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolProductSettingsDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ToolProductSettingsRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ToolProductSettings = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolProductSettingsDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.ToolProductSettingsUpdateJSONRequestBody(ddr.requestBody())
+	apiResp, err := client.ToolProductSettingsUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ToolProductSettings = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolProductSettingsDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ToolProductSettingsDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type toolProductSettingsResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &toolProductSettingsResource{}
+var _ resource.ResourceWithImportState = &toolProductSettingsResource{}
+
+func NewToolProductSettingsResource() resource.Resource {
+	return &toolProductSettingsResource{
+		terraformResource: terraformResource{
+			dataProvider: toolProductSettingsDataProvider{},
+		},
+	}
+}
+
+func (r toolProductSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_product_settings"
+}
+
+type toolProductSettingsDataProvider struct{}
+
+func (r toolProductSettingsDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data toolProductSettingsResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *toolProductSettingsResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *toolProductSettingsResourceData) defectdojoResource() defectdojoResource {
+	return &toolProductSettingsDefectdojoResource{
+		ToolProductSettings: dd.ToolProductSettings{},
+	}
+}
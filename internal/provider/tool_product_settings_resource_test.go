@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccToolProductSettingsResource(t *testing.T) {
+	name := fmt.Sprintf("dox-test-tool-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccToolProductSettingsResourceConfig(name, "my-project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_tool_product_settings.test", "name", name),
+					resource.TestCheckResourceAttr("defectdojo_tool_product_settings.test", "tool_project_id", "my-project"),
+					resource.TestCheckResourceAttr("defectdojo_tool_product_settings.test", "setting_url", "https://sonarqube.example.com/project/settings"),
+					resource.TestCheckResourceAttrPair("defectdojo_tool_product_settings.test", "product_id", "defectdojo_product.test", "id"),
+					resource.TestCheckResourceAttrPair("defectdojo_tool_product_settings.test", "tool_configuration_id", "defectdojo_tool_configuration.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_tool_product_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccToolProductSettingsResourceConfig(name, "other-project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_tool_product_settings.test", "tool_project_id", "other-project"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccToolProductSettingsResourceDeleteDrift(t *testing.T) {
+	name := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccToolProductSettingsResourceConfig(name, "my-project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_tool_product_settings.test", "name", name),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccToolProductSettingsResourceConfig(name, "my-project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_tool_product_settings.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccToolProductSettingsResourceConfig(name string, project string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_tool_type" "test" {
+  name = %[1]q
+}
+resource "defectdojo_tool_configuration" "test" {
+  name = %[1]q
+  tool_type_id = defectdojo_tool_type.test.id
+  url = "https://sonarqube.example.com"
+  authentication_type = "API"
+  api_key = "api-secret"
+}
+resource "defectdojo_product" "test" {
+  name = %[1]q
+  description = "test"
+  product_type_id = 1
+}
+resource "defectdojo_tool_product_settings" "test" {
+  name = %[1]q
+  product_id = defectdojo_product.test.id
+  tool_configuration_id = defectdojo_tool_configuration.test.id
+  tool_project_id = %[2]q
+  setting_url = "https://sonarqube.example.com/project/settings"
+}
+`, name, project)
+}
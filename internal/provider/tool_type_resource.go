@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t toolTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A DefectDojo Tool Type, such as SonarQube or Edgescan. Tool Configurations are bound to a Tool Type.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Tool Type",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(200),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Tool Type",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type toolTypeResourceData struct {
+	Name        types.String `tfsdk:"name" ddField:"Name"`
+	Description types.String `tfsdk:"description" ddField:"Description"`
+	Id          types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type toolTypeDefectdojoResource struct {
+	dd.ToolType
+}
+
+func (ddr *toolTypeDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.ToolTypesCreateJSONRequestBody(ddr.ToolType)
+	apiResp, err := client.ToolTypesCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		ddr.ToolType = *apiResp.JSON201
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolTypeDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ToolTypesRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ToolType = *apiResp.JSON200
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolTypeDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	reqBody := dd.ToolTypesUpdateJSONRequestBody(ddr.ToolType)
+	apiResp, err := client.ToolTypesUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.ToolType = *apiResp.JSON200
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *toolTypeDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.ToolTypesDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+type toolTypeResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &toolTypeResource{}
+var _ resource.ResourceWithImportState = &toolTypeResource{}
+
+func NewToolTypeResource() resource.Resource {
+	return &toolTypeResource{
+		terraformResource: terraformResource{
+			dataProvider: toolTypeDataProvider{},
+		},
+	}
+}
+
+func (r toolTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tool_type"
+}
+
+type toolTypeDataProvider struct{}
+
+func (r toolTypeDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data toolTypeResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *toolTypeResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *toolTypeResourceData) defectdojoResource() defectdojoResource {
+	return &toolTypeDefectdojoResource{
+		ToolType: dd.ToolType{},
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// fileSha256 returns the hex encoded SHA256 digest of the contents of the file at filePath.
+func fileSha256(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// newMultipartFileBody builds a multipart/form-data request body containing the given form
+// fields and, if filePath is not empty, the contents of that file under fileField. It returns the
+// body along with the content type (including the boundary) to send with it.
+func newMultipartFileBody(fields url.Values, fileField string, filePath string) (io.Reader, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	// write the fields in a stable order so that requests are reproducible
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range fields[key] {
+			if err := writer.WriteField(key, value); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	if filePath != "" {
+		f, err := os.Open(filePath)
+		if err != nil {
+			return nil, "", err
+		}
+		defer f.Close()
+
+		part, err := writer.CreateFormFile(fileField, filepath.Base(filePath))
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := io.Copy(part, f); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (t userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Data source for Defect Dojo User. You can specify either the `username` or the `email` to look up the User.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of the User",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the User",
+				Optional:            true,
+				Computed:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The first name of the User",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The last name of the User",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the User can log in",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type userDataSourceData struct {
+	Username  types.String `tfsdk:"username"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+	Id        types.String `tfsdk:"id"`
+}
+
+type userDataSource struct {
+	client *dd.ClientWithResponses
+}
+
+func (d userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+func (r *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dd.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected dd.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (d userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var (
+		params dd.UsersListParams
+	)
+	if !data.Username.IsNull() {
+		params.Username = ref.Of(data.Username.ValueString())
+	}
+
+	if !data.Email.IsNull() {
+		params.Email = ref.Of(data.Email.ValueString())
+	}
+
+	apiResp, err := d.client.UsersListWithResponse(ctx, &params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Retrieving Resource",
+			fmt.Sprintf("%s", err))
+		return
+	}
+
+	if apiResp.StatusCode() == 200 {
+		var user dd.User
+		if *apiResp.JSON200.Count == 0 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				"No Users matched the given parameters.")
+			return
+		} else if *apiResp.JSON200.Count > 1 {
+			resp.Diagnostics.AddError(
+				"Could not Retrieve Data Resource",
+				fmt.Sprintf("%d Users matched the given parameters.\n\nResponse:\n\n%s", *apiResp.JSON200.Count, apiResp.Body))
+			return
+		} else {
+			user = (*apiResp.JSON200.Results)[0]
+
+			data.Id = types.StringValue(fmt.Sprintf("%d", user.Id))
+			data.Username = types.StringValue(user.Username)
+			data.Email = nonEmptyStringValue((*string)(user.Email))
+			data.FirstName = nonEmptyStringValue(user.FirstName)
+			data.LastName = nonEmptyStringValue(user.LastName)
+			data.IsActive = types.BoolValue(user.IsActive != nil && *user.IsActive)
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"API Error Retrieving Data Source",
+			fmt.Sprintf("Unexpected response code from API: %d", apiResp.StatusCode())+
+				fmt.Sprintf("\n\nbody:\n\n%+v", string(apiResp.Body)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
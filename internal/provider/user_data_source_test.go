@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserUsernameDataSource(t *testing.T) {
+	username := fmt.Sprintf("dox-test-user-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUserDataSourceConfig(username, fmt.Sprintf(`username = %q`, username)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_user.test", "id", "defectdojo_user.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_user.test", "username", username),
+					resource.TestCheckResourceAttr("data.defectdojo_user.test", "email", fmt.Sprintf("%s@example.com", username)),
+					resource.TestCheckResourceAttr("data.defectdojo_user.test", "first_name", "Test"),
+					resource.TestCheckResourceAttr("data.defectdojo_user.test", "last_name", "User"),
+					resource.TestCheckResourceAttr("data.defectdojo_user.test", "is_active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserEmailDataSource(t *testing.T) {
+	username := fmt.Sprintf("dox-test-user-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUserDataSourceConfig(username, fmt.Sprintf(`email = "%s@example.com"`, username)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.defectdojo_user.test", "id", "defectdojo_user.test", "id"),
+					resource.TestCheckResourceAttr("data.defectdojo_user.test", "username", username),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSourceNoMatch(t *testing.T) {
+	username := fmt.Sprintf("dox-test-user-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ExpectError: regexp.MustCompile(`No Users matched the given parameters`),
+				Config: fmt.Sprintf(`
+provider "defectdojo" {}
+data "defectdojo_user" "test" {
+  username = %q
+}
+`, username),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig(username string, lookup string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_user" "test" {
+  username = %[1]q
+  email = "%[1]s@example.com"
+  first_name = "Test"
+  last_name = "User"
+}
+data "defectdojo_user" "test" {
+  %[2]s
+  depends_on = [defectdojo_user.test]
+}
+`, username, lookup)
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func (t userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DefectDojo User",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of the User. 150 characters or fewer. Letters, digits and @/./+/-/_ only.",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the User",
+				Required:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The first name of the User",
+				Optional:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The last name of the User",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The initial password of the User. It is only sent when the User is created and is never read back, so changing it afterwards has no effect.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the User can log in. Deactivate Users instead of deleting them to keep their history.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(true),
+				},
+			},
+			"is_superuser": schema.BoolAttribute{
+				MarkdownDescription: "Whether the User has all permissions without explicitly assigning them",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(false),
+				},
+			},
+			"global_role_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Role the User has for all Product Types and Products",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{ // the id (for import purposes) MUST be a string
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"contact_info": schema.SingleNestedBlock{
+				MarkdownDescription: "The contact information of the User. Contact information is only read back while this block is configured, and removing the block leaves the contact information in place.",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The job title of the User",
+						Optional:            true,
+					},
+					"phone_number": schema.StringAttribute{
+						MarkdownDescription: "Phone number in the format `+999999999`. Up to 15 digits allowed.",
+						Optional:            true,
+					},
+					"cell_number": schema.StringAttribute{
+						MarkdownDescription: "Cell phone number in the format `+999999999`. Up to 15 digits allowed.",
+						Optional:            true,
+					},
+					"slack_username": schema.StringAttribute{
+						MarkdownDescription: "Email address associated with the User's Slack account",
+						Optional:            true,
+					},
+					"slack_user_id": schema.StringAttribute{
+						MarkdownDescription: "The Slack user id of the User",
+						Optional:            true,
+					},
+					"block_execution": schema.BoolAttribute{
+						MarkdownDescription: "Deduplicate findings imported by the User synchronously instead of asynchronously, blocking until it completes",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolDefault(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type userResourceData struct {
+	Username     types.String `tfsdk:"username" ddField:"Username"`
+	Email        types.String `tfsdk:"email" ddField:"Email"`
+	FirstName    types.String `tfsdk:"first_name" ddField:"FirstName"`
+	LastName     types.String `tfsdk:"last_name" ddField:"LastName"`
+	Password     types.String `tfsdk:"password" ddField:"Password"`
+	IsActive     types.Bool   `tfsdk:"is_active" ddField:"IsActive"`
+	IsSuperuser  types.Bool   `tfsdk:"is_superuser" ddField:"IsSuperuser"`
+	GlobalRoleId types.Int64  `tfsdk:"global_role_id" ddField:"GlobalRole"`
+	ContactInfo  types.Object `tfsdk:"contact_info" ddField:"ContactInfo"`
+	Id           types.String `tfsdk:"id" ddField:"Id"`
+}
+
+type userContactInfoData struct {
+	Title          types.String `tfsdk:"title"`
+	PhoneNumber    types.String `tfsdk:"phone_number"`
+	CellNumber     types.String `tfsdk:"cell_number"`
+	SlackUsername  types.String `tfsdk:"slack_username"`
+	SlackUserId    types.String `tfsdk:"slack_user_id"`
+	BlockExecution types.Bool   `tfsdk:"block_execution"`
+}
+
+var userContactInfoAttrTypes = map[string]attr.Type{
+	"title":           types.StringType,
+	"phone_number":    types.StringType,
+	"cell_number":     types.StringType,
+	"slack_username":  types.StringType,
+	"slack_user_id":   types.StringType,
+	"block_execution": types.BoolType,
+}
+
+type userDefectdojoResource struct {
+	dd.User
+	// the global role and the contact info live in their own endpoints
+	GlobalRole  *int
+	ContactInfo types.Object
+}
+
+func (ddr *userDefectdojoResource) createApiCall(ctx context.Context, client *dd.ClientWithResponses) (int, []byte, error) {
+	reqBody := dd.UsersCreateJSONRequestBody(ddr.User)
+	apiResp, err := client.UsersCreateWithResponse(ctx, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON201 != nil {
+		password := ddr.Password
+		ddr.User = *apiResp.JSON201
+		ddr.Password = password
+
+		err := syncGlobalRole(ctx, client, dd.GlobalRole{User: &ddr.Id, Role: ddr.GlobalRole})
+		if err == nil {
+			err = ddr.syncContactInfo(ctx, client)
+		}
+		if err != nil {
+			// roll back, otherwise retrying would fail on the username that is now taken
+			_, _, _ = ddr.deleteApiCall(ctx, client, ddr.Id)
+			return 0, nil, err
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *userDefectdojoResource) readApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.UsersRetrieveWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		// the password is never returned, so keep the one we have
+		password := ddr.Password
+		ddr.User = *apiResp.JSON200
+		ddr.Password = password
+
+		ddr.GlobalRole, err = readGlobalRole(ctx, client, dd.GlobalRolesListParams{User: &ddr.Id})
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := ddr.readContactInfo(ctx, client); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *userDefectdojoResource) updateApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	// the password is only set when the user is created
+	password := ddr.Password
+	ddr.Password = nil
+
+	reqBody := dd.UsersUpdateJSONRequestBody(ddr.User)
+	apiResp, err := client.UsersUpdateWithResponse(ctx, idNumber, reqBody)
+	if err != nil {
+		return 0, nil, err
+	}
+	if apiResp.JSON200 != nil {
+		ddr.User = *apiResp.JSON200
+
+		if err := syncGlobalRole(ctx, client, dd.GlobalRole{User: &ddr.Id, Role: ddr.GlobalRole}); err != nil {
+			return 0, nil, err
+		}
+		if err := ddr.syncContactInfo(ctx, client); err != nil {
+			return 0, nil, err
+		}
+	}
+	ddr.Password = password
+
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *userDefectdojoResource) deleteApiCall(ctx context.Context, client *dd.ClientWithResponses, idNumber int) (int, []byte, error) {
+	apiResp, err := client.UsersDestroyWithResponse(ctx, idNumber)
+	if err != nil {
+		return 0, nil, err
+	}
+	return apiResp.StatusCode(), apiResp.Body, err
+}
+
+func (ddr *userDefectdojoResource) findContactInfo(ctx context.Context, client *dd.ClientWithResponses) (*dd.UserContactInfo, error) {
+	apiResp, err := client.UserContactInfosListWithResponse(ctx, &dd.UserContactInfosListParams{User: &ddr.Id})
+	if err != nil {
+		return nil, err
+	}
+	if apiResp.StatusCode() != 200 || apiResp.JSON200 == nil {
+		return nil, fmt.Errorf("Unexpected response code from the user contact infos API: %d\n\nbody:\n\n%+v", apiResp.StatusCode(), string(apiResp.Body))
+	}
+	if apiResp.JSON200.Results == nil || len(*apiResp.JSON200.Results) == 0 {
+		return nil, nil
+	}
+	return &(*apiResp.JSON200.Results)[0], nil
+}
+
+func (ddr *userDefectdojoResource) readContactInfo(ctx context.Context, client *dd.ClientWithResponses) error {
+	// contact info is only managed while the block is configured
+	if ddr.ContactInfo.IsNull() || ddr.ContactInfo.IsUnknown() {
+		return nil
+	}
+
+	contactInfo, err := ddr.findContactInfo(ctx, client)
+	if err != nil {
+		return err
+	}
+	if contactInfo != nil {
+		ddr.ContactInfo = userContactInfoObject(*contactInfo)
+	} else {
+		ddr.ContactInfo = types.ObjectNull(userContactInfoAttrTypes)
+	}
+	return nil
+}
+
+// syncContactInfo creates or updates the contact info of the user to match ContactInfo. If the
+// block is not configured, the contact info is left alone.
+func (ddr *userDefectdojoResource) syncContactInfo(ctx context.Context, client *dd.ClientWithResponses) error {
+	if ddr.ContactInfo.IsNull() || ddr.ContactInfo.IsUnknown() {
+		return nil
+	}
+
+	var data userContactInfoData
+	diags := ddr.ContactInfo.As(ctx, &data, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmt.Errorf("Could not read the contact_info block: %v", diags)
+	}
+
+	existing, err := ddr.findContactInfo(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	contactInfo := dd.UserContactInfo{
+		User:           ddr.Id,
+		Title:          stringPointer(data.Title),
+		PhoneNumber:    stringPointer(data.PhoneNumber),
+		CellNumber:     stringPointer(data.CellNumber),
+		SlackUsername:  stringPointer(data.SlackUsername),
+		SlackUserId:    stringPointer(data.SlackUserId),
+		BlockExecution: boolPointer(data.BlockExecution),
+	}
+
+	var statusCode, expectedStatusCode int
+	var body []byte
+	var result *dd.UserContactInfo
+	if existing == nil {
+		apiResp, err := client.UserContactInfosCreateWithResponse(ctx, dd.UserContactInfosCreateJSONRequestBody(contactInfo))
+		if err != nil {
+			return err
+		}
+		statusCode, expectedStatusCode, body, result = apiResp.StatusCode(), 201, apiResp.Body, apiResp.JSON201
+	} else {
+		contactInfo.Id = existing.Id
+		apiResp, err := client.UserContactInfosUpdateWithResponse(ctx, existing.Id, dd.UserContactInfosUpdateJSONRequestBody(contactInfo))
+		if err != nil {
+			return err
+		}
+		statusCode, expectedStatusCode, body, result = apiResp.StatusCode(), 200, apiResp.Body, apiResp.JSON200
+	}
+
+	if statusCode != expectedStatusCode || result == nil {
+		return fmt.Errorf("Unexpected response code from the user contact infos API: %d\n\nbody:\n\n%+v", statusCode, string(body))
+	}
+	ddr.ContactInfo = userContactInfoObject(*result)
+	return nil
+}
+
+// userContactInfoObject converts the contact info returned by the API to the value of the
+// contact_info block. The API returns empty strings for fields that were never set, which we
+// treat as null.
+func userContactInfoObject(contactInfo dd.UserContactInfo) types.Object {
+	blockExecution := false
+	if contactInfo.BlockExecution != nil {
+		blockExecution = *contactInfo.BlockExecution
+	}
+	return types.ObjectValueMust(userContactInfoAttrTypes, map[string]attr.Value{
+		"title":           nonEmptyStringValue(contactInfo.Title),
+		"phone_number":    nonEmptyStringValue(contactInfo.PhoneNumber),
+		"cell_number":     nonEmptyStringValue(contactInfo.CellNumber),
+		"slack_username":  nonEmptyStringValue(contactInfo.SlackUsername),
+		"slack_user_id":   nonEmptyStringValue(contactInfo.SlackUserId),
+		"block_execution": types.BoolValue(blockExecution),
+	})
+}
+
+func nonEmptyStringValue(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+func stringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	str := value.ValueString()
+	return &str
+}
+
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	b := value.ValueBool()
+	return &b
+}
+
+type userResource struct {
+	terraformResource
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &userResource{}
+var _ resource.ResourceWithImportState = &userResource{}
+
+func NewUserResource() resource.Resource {
+	return &userResource{
+		terraformResource: terraformResource{
+			dataProvider: userDataProvider{},
+		},
+	}
+}
+
+func (r userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+type userDataProvider struct{}
+
+func (r userDataProvider) getData(ctx context.Context, getter dataGetter) (terraformResourceData, diag.Diagnostics) {
+	var data userResourceData
+	diags := getter.Get(ctx, &data)
+	return &data, diags
+}
+
+func (d *userResourceData) id() types.String {
+	return d.Id
+}
+
+func (d *userResourceData) defectdojoResource() defectdojoResource {
+	return &userDefectdojoResource{
+		User:        dd.User{},
+		ContactInfo: types.ObjectNull(userContactInfoAttrTypes),
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUserResource(t *testing.T) {
+	username := fmt.Sprintf("dox-test-user-%s", resource.UniqueId())
+	updatedUsername := fmt.Sprintf("dox-new-user-%s", resource.UniqueId())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_user.test", "username", username),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "email", "test@example.com"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "first_name", "Test"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "last_name", "User"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "password", "Sup3r-S3cret-Passw0rd"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "is_superuser", "false"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "global_role_id", "5"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.title", "Engineer"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.phone_number", "+15555550100"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.slack_username", "test@example.com"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.slack_user_id", "U12345"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "contact_info.block_execution", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "defectdojo_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// neither of these can be read back from the API after an import
+				ImportStateVerifyIgnore: []string{"password", "contact_info"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserResourceMinimalConfig(updatedUsername),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_user.test", "username", updatedUsername),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "email", "other@example.com"),
+					resource.TestCheckResourceAttr("defectdojo_user.test", "is_active", "false"),
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "first_name"),
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "last_name"),
+					resource.TestCheckNoResourceAttr("defectdojo_user.test", "global_role_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserResourceDeleteDrift(t *testing.T) {
+	username := fmt.Sprintf("dox-delete-%s", resource.UniqueId())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceMinimalConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_user.test", "username", username),
+				),
+			},
+			// Delete the underlying resource and see that it detects it has been deleted
+			{
+				ExpectNonEmptyPlan: true,
+				Config:             testAccUserResourceMinimalConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccDeleteResourceOutsideTerraform("defectdojo_user.test"),
+				),
+			},
+			{
+				Config: testAccUserResourceMinimalConfig(username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("defectdojo_user.test", "username", username),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserResourceConfig(username string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_user" "test" {
+  username = %[1]q
+  email = "test@example.com"
+  first_name = "Test"
+  last_name = "User"
+  password = "Sup3r-S3cret-Passw0rd"
+  global_role_id = 5
+
+  contact_info {
+    title = "Engineer"
+    phone_number = "+15555550100"
+    slack_username = "test@example.com"
+    slack_user_id = "U12345"
+    block_execution = true
+  }
+}
+`, username)
+}
+
+func testAccUserResourceMinimalConfig(username string) string {
+	return fmt.Sprintf(`
+provider "defectdojo" {}
+resource "defectdojo_user" "test" {
+  username = %[1]q
+  email = "other@example.com"
+  is_active = false
+}
+`, username)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dd "github.com/doximity/defect-dojo-client-go"
+	"github.com/doximity/terraform-provider-defectdojo/internal/ref"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"gotest.tools/assert"
+)
+
+func TestUserResource__defectdojoResource(t *testing.T) {
+	contactInfo := userContactInfoObject(dd.UserContactInfo{Title: ref.Of("Engineer")})
+	userResource := userResourceData{
+		Username:     types.StringValue("jdoe"),
+		Email:        types.StringValue("jdoe@example.com"),
+		Password:     types.StringValue("secret"),
+		GlobalRoleId: types.Int64Value(5),
+		ContactInfo:  contactInfo,
+	}
+	var terraformResource terraformResourceData = &userResource
+
+	diags := diag.Diagnostics{}
+	ddResource := terraformResource.defectdojoResource()
+	populateDefectdojoResource(context.Background(), &diags, terraformResource, &ddResource)
+	assert.Equal(t, diags.HasError(), false)
+
+	ddUser := ddResource.(*userDefectdojoResource)
+	assert.Equal(t, ddUser.Username, "jdoe")
+	assert.Equal(t, string(*ddUser.Email), "jdoe@example.com")
+	assert.Equal(t, *ddUser.Password, "secret")
+	assert.Equal(t, *ddUser.GlobalRole, 5)
+	assert.Assert(t, ddUser.FirstName == nil)
+	assert.Assert(t, ddUser.ContactInfo.Equal(contactInfo))
+}
+
+func TestUserResourcePopulate(t *testing.T) {
+	ddUser := userDefectdojoResource{
+		User: dd.User{
+			Id:       42,
+			Username: "jdoe",
+			IsActive: ref.Of(true),
+		},
+		ContactInfo: types.ObjectNull(userContactInfoAttrTypes),
+	}
+
+	userResource := userResourceData{}
+	var terraformResource terraformResourceData = &userResource
+
+	diags := diag.Diagnostics{}
+	populateResourceData(context.Background(), &diags, &terraformResource, &ddUser)
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, userResource.Id.ValueString(), "42")
+	assert.Equal(t, userResource.Username.ValueString(), "jdoe")
+	assert.Equal(t, userResource.IsActive.ValueBool(), true)
+	assert.Equal(t, userResource.Email.IsNull(), true)
+	assert.Equal(t, userResource.GlobalRoleId.IsNull(), true)
+	assert.Equal(t, userResource.ContactInfo.IsNull(), true)
+}
+
+func TestUserContactInfoObject(t *testing.T) {
+	contactInfo := userContactInfoObject(dd.UserContactInfo{
+		Title:         ref.Of("Engineer"),
+		PhoneNumber:   ref.Of(""),
+		SlackUsername: ref.Of("jdoe@example.com"),
+	})
+
+	var data userContactInfoData
+	diags := contactInfo.As(context.Background(), &data, basetypes.ObjectAsOptions{})
+	assert.Equal(t, diags.HasError(), false)
+	assert.Equal(t, data.Title.ValueString(), "Engineer")
+	// empty strings returned by the API are treated as unset
+	assert.Equal(t, data.PhoneNumber.IsNull(), true)
+	assert.Equal(t, data.CellNumber.IsNull(), true)
+	assert.Equal(t, data.SlackUsername.ValueString(), "jdoe@example.com")
+	assert.Equal(t, data.BlockExecution.ValueBool(), false)
+}
+
+func TestUserResourceCreateRemovesUserWhenGlobalRoleFails(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v2/users/":
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id": 5, "username": "jdoe"}`))
+		case "GET /api/v2/global_roles/":
+			_, _ = w.Write([]byte(`{"count": 0, "next": null, "results": []}`))
+		case "POST /api/v2/global_roles/":
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"role": ["Invalid pk"]}`))
+		case "DELETE /api/v2/users/5/":
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddUser := userDefectdojoResource{
+		User:        dd.User{Username: "jdoe"},
+		GlobalRole:  ref.Of(42),
+		ContactInfo: types.ObjectNull(userContactInfoAttrTypes),
+	}
+	_, _, err = ddUser.createApiCall(context.Background(), client)
+	assert.Assert(t, err != nil)
+	assert.DeepEqual(t, requests, []string{"POST /api/v2/users/", "GET /api/v2/global_roles/", "POST /api/v2/global_roles/", "DELETE /api/v2/users/5/"})
+}
+
+func TestUserResourceCreateRollbackTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/v2/users/":
+			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id": 5, "username": "jdoe"}`))
+		case "GET /api/v2/global_roles/":
+			_, _ = w.Write([]byte(`{"count": 0, "next": null, "results": []}`))
+		case "POST /api/v2/global_roles/":
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"role": ["Invalid pk"]}`))
+		case "DELETE /api/v2/users/5/":
+			// drop the connection, so that the rollback fails with a transport error
+			conn, _, err := w.(http.Hijacker).Hijack()
+			assert.NilError(t, err)
+			conn.Close()
+		}
+	}))
+	defer server.Close()
+
+	client, err := dd.NewClientWithResponses(server.URL + "/")
+	assert.NilError(t, err)
+
+	ddUser := userDefectdojoResource{
+		User:        dd.User{Username: "jdoe"},
+		GlobalRole:  ref.Of(42),
+		ContactInfo: types.ObjectNull(userContactInfoAttrTypes),
+	}
+	_, _, err = ddUser.createApiCall(context.Background(), client)
+	assert.Assert(t, err != nil)
+}
+
+func TestUserResourceTransportErrors(t *testing.T) {
+	assertTransportErrors(t, &userDefectdojoResource{ContactInfo: types.ObjectNull(userContactInfoAttrTypes)})
+}
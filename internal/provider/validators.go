@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// jsonObjectValidator is a validator that makes sure a types.StringType
+// attribute holds a JSON object, such as the output of `jsonencode()`.
+type jsonObjectValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+// ValidateString runs the logic of the validator.
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil || object == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			"The value must be a JSON object, for example the output of jsonencode().",
+		)
+	}
+}
+
+func jsonObject() jsonObjectValidator {
+	return jsonObjectValidator{}
+}
+
+// normalizeJson returns the compact form of a JSON document with the keys of
+// its objects sorted, so that documents can be compared regardless of their
+// formatting.
+func normalizeJson(document []byte) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(document, &value); err != nil {
+		return "", err
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}